@@ -11,6 +11,7 @@ import (
 	sync "sync"
 
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	status "google.golang.org/genproto/googleapis/rpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -23,6 +24,287 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// RoundingMode is the way the converted values are rounded to the minor units of the currency.
+type RoundingMode int32
+
+const (
+	// Not specified, the default rounding mode of the deployment is used.
+	RoundingMode_ROUNDING_MODE_UNSPECIFIED RoundingMode = 0
+	// Round to the nearest, ties to the even neighbour. Used for accounting.
+	RoundingMode_ROUNDING_MODE_HALF_EVEN RoundingMode = 1
+	// Round to the nearest, ties away from zero. Used for display.
+	RoundingMode_ROUNDING_MODE_HALF_UP RoundingMode = 2
+	// Round towards negative infinity. Used for payouts.
+	RoundingMode_ROUNDING_MODE_FLOOR RoundingMode = 3
+	// Round towards positive infinity. Used for charges.
+	RoundingMode_ROUNDING_MODE_CEILING RoundingMode = 4
+)
+
+// Enum value maps for RoundingMode.
+var (
+	RoundingMode_name = map[int32]string{
+		0: "ROUNDING_MODE_UNSPECIFIED",
+		1: "ROUNDING_MODE_HALF_EVEN",
+		2: "ROUNDING_MODE_HALF_UP",
+		3: "ROUNDING_MODE_FLOOR",
+		4: "ROUNDING_MODE_CEILING",
+	}
+	RoundingMode_value = map[string]int32{
+		"ROUNDING_MODE_UNSPECIFIED": 0,
+		"ROUNDING_MODE_HALF_EVEN":   1,
+		"ROUNDING_MODE_HALF_UP":     2,
+		"ROUNDING_MODE_FLOOR":       3,
+		"ROUNDING_MODE_CEILING":     4,
+	}
+)
+
+func (x RoundingMode) Enum() *RoundingMode {
+	p := new(RoundingMode)
+	*p = x
+	return p
+}
+
+func (x RoundingMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoundingMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_enumTypes[0].Descriptor()
+}
+
+func (RoundingMode) Type() protoreflect.EnumType {
+	return &file_v1alpha1_currencyconverter_currency_converter_server_proto_enumTypes[0]
+}
+
+func (x RoundingMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoundingMode.Descriptor instead.
+func (RoundingMode) EnumDescriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{0}
+}
+
+// HistoricalRatesSource is where the rates of a past date were read from.
+type HistoricalRatesSource int32
+
+const (
+	// Not specified.
+	HistoricalRatesSource_HISTORICAL_RATES_SOURCE_UNSPECIFIED HistoricalRatesSource = 0
+	// The last rates refreshed that day, from the history of the service.
+	HistoricalRatesSource_HISTORICAL_RATES_SOURCE_HISTORY HistoricalRatesSource = 1
+	// The historical rates of the exchange provider, for the dates the service has no history of.
+	HistoricalRatesSource_HISTORICAL_RATES_SOURCE_PROVIDER HistoricalRatesSource = 2
+)
+
+// Enum value maps for HistoricalRatesSource.
+var (
+	HistoricalRatesSource_name = map[int32]string{
+		0: "HISTORICAL_RATES_SOURCE_UNSPECIFIED",
+		1: "HISTORICAL_RATES_SOURCE_HISTORY",
+		2: "HISTORICAL_RATES_SOURCE_PROVIDER",
+	}
+	HistoricalRatesSource_value = map[string]int32{
+		"HISTORICAL_RATES_SOURCE_UNSPECIFIED": 0,
+		"HISTORICAL_RATES_SOURCE_HISTORY":     1,
+		"HISTORICAL_RATES_SOURCE_PROVIDER":    2,
+	}
+)
+
+func (x HistoricalRatesSource) Enum() *HistoricalRatesSource {
+	p := new(HistoricalRatesSource)
+	*p = x
+	return p
+}
+
+func (x HistoricalRatesSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HistoricalRatesSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_enumTypes[1].Descriptor()
+}
+
+func (HistoricalRatesSource) Type() protoreflect.EnumType {
+	return &file_v1alpha1_currencyconverter_currency_converter_server_proto_enumTypes[1]
+}
+
+func (x HistoricalRatesSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HistoricalRatesSource.Descriptor instead.
+func (HistoricalRatesSource) EnumDescriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{1}
+}
+
+// TimeSeriesGranularity is the period the rates of a time series are aggregated by, in UTC.
+type TimeSeriesGranularity int32
+
+const (
+	// Not specified, the rates are aggregated by day.
+	TimeSeriesGranularity_TIME_SERIES_GRANULARITY_UNSPECIFIED TimeSeriesGranularity = 0
+	// The rates are aggregated by UTC day, from the last refresh of the day only.
+	TimeSeriesGranularity_TIME_SERIES_GRANULARITY_DAY TimeSeriesGranularity = 1
+	// The rates are aggregated by hour.
+	TimeSeriesGranularity_TIME_SERIES_GRANULARITY_HOUR TimeSeriesGranularity = 2
+)
+
+// Enum value maps for TimeSeriesGranularity.
+var (
+	TimeSeriesGranularity_name = map[int32]string{
+		0: "TIME_SERIES_GRANULARITY_UNSPECIFIED",
+		1: "TIME_SERIES_GRANULARITY_DAY",
+		2: "TIME_SERIES_GRANULARITY_HOUR",
+	}
+	TimeSeriesGranularity_value = map[string]int32{
+		"TIME_SERIES_GRANULARITY_UNSPECIFIED": 0,
+		"TIME_SERIES_GRANULARITY_DAY":         1,
+		"TIME_SERIES_GRANULARITY_HOUR":        2,
+	}
+)
+
+func (x TimeSeriesGranularity) Enum() *TimeSeriesGranularity {
+	p := new(TimeSeriesGranularity)
+	*p = x
+	return p
+}
+
+func (x TimeSeriesGranularity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeSeriesGranularity) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_enumTypes[2].Descriptor()
+}
+
+func (TimeSeriesGranularity) Type() protoreflect.EnumType {
+	return &file_v1alpha1_currencyconverter_currency_converter_server_proto_enumTypes[2]
+}
+
+func (x TimeSeriesGranularity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeSeriesGranularity.Descriptor instead.
+func (TimeSeriesGranularity) EnumDescriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{2}
+}
+
+// ProviderCapability is what an exchange provider can be used for.
+type ProviderCapability int32
+
+const (
+	// Not specified.
+	ProviderCapability_PROVIDER_CAPABILITY_UNSPECIFIED ProviderCapability = 0
+	// Live rates against USD, for the conversions and the live rates.
+	ProviderCapability_PROVIDER_CAPABILITY_LIVE_RATES ProviderCapability = 1
+	// Live rates against the pivot currencies, to triangulate the pairs.
+	ProviderCapability_PROVIDER_CAPABILITY_PIVOT_RATES ProviderCapability = 2
+	// Rates of past dates, for the historical rates of the dates the service has no history of.
+	ProviderCapability_PROVIDER_CAPABILITY_HISTORICAL_RATES ProviderCapability = 3
+)
+
+// Enum value maps for ProviderCapability.
+var (
+	ProviderCapability_name = map[int32]string{
+		0: "PROVIDER_CAPABILITY_UNSPECIFIED",
+		1: "PROVIDER_CAPABILITY_LIVE_RATES",
+		2: "PROVIDER_CAPABILITY_PIVOT_RATES",
+		3: "PROVIDER_CAPABILITY_HISTORICAL_RATES",
+	}
+	ProviderCapability_value = map[string]int32{
+		"PROVIDER_CAPABILITY_UNSPECIFIED":      0,
+		"PROVIDER_CAPABILITY_LIVE_RATES":       1,
+		"PROVIDER_CAPABILITY_PIVOT_RATES":      2,
+		"PROVIDER_CAPABILITY_HISTORICAL_RATES": 3,
+	}
+)
+
+func (x ProviderCapability) Enum() *ProviderCapability {
+	p := new(ProviderCapability)
+	*p = x
+	return p
+}
+
+func (x ProviderCapability) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProviderCapability) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_enumTypes[3].Descriptor()
+}
+
+func (ProviderCapability) Type() protoreflect.EnumType {
+	return &file_v1alpha1_currencyconverter_currency_converter_server_proto_enumTypes[3]
+}
+
+func (x ProviderCapability) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProviderCapability.Descriptor instead.
+func (ProviderCapability) EnumDescriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{3}
+}
+
+// ProviderHealth is the reachability of an exchange provider.
+type ProviderHealth int32
+
+const (
+	// Not specified.
+	ProviderHealth_PROVIDER_HEALTH_UNSPECIFIED ProviderHealth = 0
+	// The last fetch from the provider succeeded, or it was not fetched yet.
+	ProviderHealth_PROVIDER_HEALTH_HEALTHY ProviderHealth = 1
+	// The fetches from the provider are failing, for less than the failure window.
+	ProviderHealth_PROVIDER_HEALTH_DEGRADED ProviderHealth = 2
+	// The fetches from the provider are failing, for more than the failure window.
+	ProviderHealth_PROVIDER_HEALTH_UNAVAILABLE ProviderHealth = 3
+)
+
+// Enum value maps for ProviderHealth.
+var (
+	ProviderHealth_name = map[int32]string{
+		0: "PROVIDER_HEALTH_UNSPECIFIED",
+		1: "PROVIDER_HEALTH_HEALTHY",
+		2: "PROVIDER_HEALTH_DEGRADED",
+		3: "PROVIDER_HEALTH_UNAVAILABLE",
+	}
+	ProviderHealth_value = map[string]int32{
+		"PROVIDER_HEALTH_UNSPECIFIED": 0,
+		"PROVIDER_HEALTH_HEALTHY":     1,
+		"PROVIDER_HEALTH_DEGRADED":    2,
+		"PROVIDER_HEALTH_UNAVAILABLE": 3,
+	}
+)
+
+func (x ProviderHealth) Enum() *ProviderHealth {
+	p := new(ProviderHealth)
+	*p = x
+	return p
+}
+
+func (x ProviderHealth) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProviderHealth) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_enumTypes[4].Descriptor()
+}
+
+func (ProviderHealth) Type() protoreflect.EnumType {
+	return &file_v1alpha1_currencyconverter_currency_converter_server_proto_enumTypes[4]
+}
+
+func (x ProviderHealth) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProviderHealth.Descriptor instead.
+func (ProviderHealth) EnumDescriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{4}
+}
+
 // Request to get a currency with value to be converted to another currency.
 type ConversionRequest struct {
 	state         protoimpl.MessageState
@@ -35,6 +317,11 @@ type ConversionRequest struct {
 	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
 	// Optionla. provider to be used for exchange rates. [default: CurrencyLayer]
 	ExchangeProvider string `protobuf:"bytes,3,opt,name=exchange_provider,json=exchangeProvider,proto3" json:"exchange_provider,omitempty"`
+	// Optional. rounding mode of the converted value. [default: configured per deployment]
+	RoundingMode RoundingMode `protobuf:"varint,4,opt,name=rounding_mode,json=roundingMode,proto3,enum=api.proto.v1alpha1.currency.converter.RoundingMode" json:"rounding_mode,omitempty"`
+	// Optional. BCP 47 locale from.value is written in, e.g. "de-DE" for "1.234,56 €".
+	// The converted value is also returned formatted in this locale. [default: a plain decimal number, e.g. "1234.56"]
+	Locale string `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`
 }
 
 func (x *ConversionRequest) Reset() {
@@ -90,6 +377,97 @@ func (x *ConversionRequest) GetExchangeProvider() string {
 	return ""
 }
 
+func (x *ConversionRequest) GetRoundingMode() RoundingMode {
+	if x != nil {
+		return x.RoundingMode
+	}
+	return RoundingMode_ROUNDING_MODE_UNSPECIFIED
+}
+
+func (x *ConversionRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// Request to get the amount of a currency to be converted to receive an exact amount of another currency.
+type ReverseConversionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// to is the currency with the exact amount to be received.
+	To *Currency `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
+	// from is the currency code of the amount to be converted.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// Optional. provider to be used for exchange rates. [default: CurrencyLayer]
+	ExchangeProvider string `protobuf:"bytes,3,opt,name=exchange_provider,json=exchangeProvider,proto3" json:"exchange_provider,omitempty"`
+	// Optional. BCP 47 locale to.value is written in, e.g. "de-DE" for "500,00 €".
+	// Both values are also returned formatted in this locale. [default: a plain decimal number, e.g. "500.00"]
+	Locale string `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *ReverseConversionRequest) Reset() {
+	*x = ReverseConversionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReverseConversionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseConversionRequest) ProtoMessage() {}
+
+func (x *ReverseConversionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseConversionRequest.ProtoReflect.Descriptor instead.
+func (*ReverseConversionRequest) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReverseConversionRequest) GetTo() *Currency {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ReverseConversionRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ReverseConversionRequest) GetExchangeProvider() string {
+	if x != nil {
+		return x.ExchangeProvider
+	}
+	return ""
+}
+
+func (x *ReverseConversionRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 // Response with the converted currency.
 type ConversionResponse struct {
 	state         protoimpl.MessageState
@@ -106,12 +484,30 @@ type ConversionResponse struct {
 	ConversionDatetime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=conversion_datetime,json=conversionDatetime,proto3" json:"conversion_datetime,omitempty"`
 	// timestamp at which the exchange rate was taken from.
 	ExchangeRateDatetime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=exchange_rate_datetime,json=exchangeRateDatetime,proto3" json:"exchange_rate_datetime,omitempty"`
+	// rate of exchange as a decimal string, with the full precision used for the conversion.
+	// exchange_rate holds the same rate, rounded to a float.
+	ExchangeRateValue string `protobuf:"bytes,6,opt,name=exchange_rate_value,json=exchangeRateValue,proto3" json:"exchange_rate_value,omitempty"`
+	// rounding mode applied to the converted value, or to the source amount of a reverse conversion.
+	RoundingMode RoundingMode `protobuf:"varint,7,opt,name=rounding_mode,json=roundingMode,proto3,enum=api.proto.v1alpha1.currency.converter.RoundingMode" json:"rounding_mode,omitempty"`
+	// base currency against which the rates of both currencies are quoted, e.g. USD.
+	// exchange_rate is derived as to_rate / from_rate.
+	// Empty when the pair was triangulated across several rates tables, see conversion_path.
+	BaseCurrency string `protobuf:"bytes,8,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	// rate of the source currency against the base currency, as a decimal string.
+	FromRate string `protobuf:"bytes,9,opt,name=from_rate,json=fromRate,proto3" json:"from_rate,omitempty"`
+	// rate of the target currency against the base currency, as a decimal string.
+	ToRate string `protobuf:"bytes,10,opt,name=to_rate,json=toRate,proto3" json:"to_rate,omitempty"`
+	// currencies the amount was converted through, from the source to the target currency, e.g. ["EUR", "USD", "BTC"].
+	ConversionPath []string `protobuf:"bytes,11,rep,name=conversion_path,json=conversionPath,proto3" json:"conversion_path,omitempty"`
+	// margin charged on top of the mid-market rate. Set when a pricing rule applies to the conversion,
+	// exchange_rate is then the applied rate.
+	Fees *Fees `protobuf:"bytes,12,opt,name=fees,proto3" json:"fees,omitempty"`
 }
 
 func (x *ConversionResponse) Reset() {
 	*x = ConversionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[1]
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -124,7 +520,7 @@ func (x *ConversionResponse) String() string {
 func (*ConversionResponse) ProtoMessage() {}
 
 func (x *ConversionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[1]
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -137,7 +533,7 @@ func (x *ConversionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConversionResponse.ProtoReflect.Descriptor instead.
 func (*ConversionResponse) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{1}
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ConversionResponse) GetConverted() *Currency {
@@ -175,76 +571,77 @@ func (x *ConversionResponse) GetExchangeRateDatetime() *timestamppb.Timestamp {
 	return nil
 }
 
-// BatchConversionRequest represents the request to convert currencies in batch.
-type BatchConversionRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// List/Array of currencies to be requested for conversions.
-	Currencies []*ConversionRequest `protobuf:"bytes,1,rep,name=currencies,proto3" json:"currencies,omitempty"`
-	// Optional. (internal) limit of a batch size allowed
-	BatchLimit uint64 `protobuf:"varint,2,opt,name=batch_limit,json=batchLimit,proto3" json:"batch_limit,omitempty"`
+func (x *ConversionResponse) GetExchangeRateValue() string {
+	if x != nil {
+		return x.ExchangeRateValue
+	}
+	return ""
 }
 
-func (x *BatchConversionRequest) Reset() {
-	*x = BatchConversionRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ConversionResponse) GetRoundingMode() RoundingMode {
+	if x != nil {
+		return x.RoundingMode
 	}
+	return RoundingMode_ROUNDING_MODE_UNSPECIFIED
 }
 
-func (x *BatchConversionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ConversionResponse) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
 }
 
-func (*BatchConversionRequest) ProtoMessage() {}
-
-func (x *BatchConversionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ConversionResponse) GetFromRate() string {
+	if x != nil {
+		return x.FromRate
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use BatchConversionRequest.ProtoReflect.Descriptor instead.
-func (*BatchConversionRequest) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{2}
+func (x *ConversionResponse) GetToRate() string {
+	if x != nil {
+		return x.ToRate
+	}
+	return ""
 }
 
-func (x *BatchConversionRequest) GetCurrencies() []*ConversionRequest {
+func (x *ConversionResponse) GetConversionPath() []string {
 	if x != nil {
-		return x.Currencies
+		return x.ConversionPath
 	}
 	return nil
 }
 
-func (x *BatchConversionRequest) GetBatchLimit() uint64 {
+func (x *ConversionResponse) GetFees() *Fees {
 	if x != nil {
-		return x.BatchLimit
+		return x.Fees
 	}
-	return 0
+	return nil
 }
 
-// BatchConversionResponse represents the response to convert currencies in batch.
-type BatchConversionResponse struct {
+// Fees is the breakdown of the margin charged on a conversion. The amounts are of the source currency.
+type Fees struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// List/Array of currencies which are converted.
-	Currencies []*ConversionResponse `protobuf:"bytes,1,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	// mid-market rate, before the spread is taken off.
+	MidRate string `protobuf:"bytes,1,opt,name=mid_rate,json=midRate,proto3" json:"mid_rate,omitempty"`
+	// rate the net amount is converted with, once the spread is taken off.
+	AppliedRate string `protobuf:"bytes,2,opt,name=applied_rate,json=appliedRate,proto3" json:"applied_rate,omitempty"`
+	// spread taken off the mid-market rate, in percent, e.g. "0.5".
+	SpreadPercent string `protobuf:"bytes,3,opt,name=spread_percent,json=spreadPercent,proto3" json:"spread_percent,omitempty"`
+	// part of the net amount kept by the spread.
+	SpreadAmount *Currency `protobuf:"bytes,4,opt,name=spread_amount,json=spreadAmount,proto3" json:"spread_amount,omitempty"`
+	// fee deducted from the source amount before it is converted, raised to reach the minimum fee.
+	FeeAmount *Currency `protobuf:"bytes,5,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
+	// source amount converted with the applied rate, i.e. the source amount without fee_amount.
+	NetAmount *Currency `protobuf:"bytes,6,opt,name=net_amount,json=netAmount,proto3" json:"net_amount,omitempty"`
 }
 
-func (x *BatchConversionResponse) Reset() {
-	*x = BatchConversionResponse{}
+func (x *Fees) Reset() {
+	*x = Fees{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -252,13 +649,13 @@ func (x *BatchConversionResponse) Reset() {
 	}
 }
 
-func (x *BatchConversionResponse) String() string {
+func (x *Fees) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchConversionResponse) ProtoMessage() {}
+func (*Fees) ProtoMessage() {}
 
-func (x *BatchConversionResponse) ProtoReflect() protoreflect.Message {
+func (x *Fees) ProtoReflect() protoreflect.Message {
 	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -270,33 +667,65 @@ func (x *BatchConversionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchConversionResponse.ProtoReflect.Descriptor instead.
-func (*BatchConversionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Fees.ProtoReflect.Descriptor instead.
+func (*Fees) Descriptor() ([]byte, []int) {
 	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *BatchConversionResponse) GetCurrencies() []*ConversionResponse {
+func (x *Fees) GetMidRate() string {
 	if x != nil {
-		return x.Currencies
+		return x.MidRate
 	}
-	return nil
+	return ""
 }
 
-// request for exchange rates for the supported currencies.
-type ListExchangeRatesRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Pagination *OffsetPaginationOptions `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	// Optional. provider to be used for exchange rates. [default: CurrencyLayer]
-	ExchangeProvider string `protobuf:"bytes,2,opt,name=exchange_provider,json=exchangeProvider,proto3" json:"exchange_provider,omitempty"`
-	// Include total count of exchange rates.
-	IncludeTotalCount bool `protobuf:"varint,3,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"`
+func (x *Fees) GetAppliedRate() string {
+	if x != nil {
+		return x.AppliedRate
+	}
+	return ""
 }
 
-func (x *ListExchangeRatesRequest) Reset() {
-	*x = ListExchangeRatesRequest{}
+func (x *Fees) GetSpreadPercent() string {
+	if x != nil {
+		return x.SpreadPercent
+	}
+	return ""
+}
+
+func (x *Fees) GetSpreadAmount() *Currency {
+	if x != nil {
+		return x.SpreadAmount
+	}
+	return nil
+}
+
+func (x *Fees) GetFeeAmount() *Currency {
+	if x != nil {
+		return x.FeeAmount
+	}
+	return nil
+}
+
+func (x *Fees) GetNetAmount() *Currency {
+	if x != nil {
+		return x.NetAmount
+	}
+	return nil
+}
+
+// request to compare a conversion across the exchange providers.
+type CompareConversionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// conversion to be converted with every provider. Its exchange_provider is ignored.
+	Conversion *ConversionRequest `protobuf:"bytes,1,opt,name=conversion,proto3" json:"conversion,omitempty"`
+}
+
+func (x *CompareConversionRequest) Reset() {
+	*x = CompareConversionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -304,13 +733,13 @@ func (x *ListExchangeRatesRequest) Reset() {
 	}
 }
 
-func (x *ListExchangeRatesRequest) String() string {
+func (x *CompareConversionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExchangeRatesRequest) ProtoMessage() {}
+func (*CompareConversionRequest) ProtoMessage() {}
 
-func (x *ListExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+func (x *CompareConversionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -322,48 +751,32 @@ func (x *ListExchangeRatesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExchangeRatesRequest.ProtoReflect.Descriptor instead.
-func (*ListExchangeRatesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CompareConversionRequest.ProtoReflect.Descriptor instead.
+func (*CompareConversionRequest) Descriptor() ([]byte, []int) {
 	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ListExchangeRatesRequest) GetPagination() *OffsetPaginationOptions {
+func (x *CompareConversionRequest) GetConversion() *ConversionRequest {
 	if x != nil {
-		return x.Pagination
+		return x.Conversion
 	}
 	return nil
 }
 
-func (x *ListExchangeRatesRequest) GetExchangeProvider() string {
-	if x != nil {
-		return x.ExchangeProvider
-	}
-	return ""
-}
-
-func (x *ListExchangeRatesRequest) GetIncludeTotalCount() bool {
-	if x != nil {
-		return x.IncludeTotalCount
-	}
-	return false
-}
-
-// response with the list of exchange rates for the supported currencies.
-type ListExchangeRatesResponse struct {
+// response with a conversion converted with every exchange provider.
+type CompareConversionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// List of currencies and their current rate.
-	Currencies []*Currency `protobuf:"bytes,1,rep,name=currencies,proto3" json:"currencies,omitempty"`
-	// total count.
-	TotalCount float64 `protobuf:"fixed64,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	// timestamp at which the exchange rate was taken from.
-	ExchangeRateDatetime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=exchange_rate_datetime,json=exchangeRateDatetime,proto3" json:"exchange_rate_datetime,omitempty"`
+	// conversion of every supported provider, ordered by provider.
+	Conversions []*ProviderConversion `protobuf:"bytes,1,rep,name=conversions,proto3" json:"conversions,omitempty"`
+	// statistics of the mid-market rates of the providers which converted.
+	Stats *ConversionRateStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
 }
 
-func (x *ListExchangeRatesResponse) Reset() {
-	*x = ListExchangeRatesResponse{}
+func (x *CompareConversionResponse) Reset() {
+	*x = CompareConversionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -371,13 +784,13 @@ func (x *ListExchangeRatesResponse) Reset() {
 	}
 }
 
-func (x *ListExchangeRatesResponse) String() string {
+func (x *CompareConversionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExchangeRatesResponse) ProtoMessage() {}
+func (*CompareConversionResponse) ProtoMessage() {}
 
-func (x *ListExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+func (x *CompareConversionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -389,46 +802,41 @@ func (x *ListExchangeRatesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExchangeRatesResponse.ProtoReflect.Descriptor instead.
-func (*ListExchangeRatesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CompareConversionResponse.ProtoReflect.Descriptor instead.
+func (*CompareConversionResponse) Descriptor() ([]byte, []int) {
 	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ListExchangeRatesResponse) GetCurrencies() []*Currency {
+func (x *CompareConversionResponse) GetConversions() []*ProviderConversion {
 	if x != nil {
-		return x.Currencies
+		return x.Conversions
 	}
 	return nil
 }
 
-func (x *ListExchangeRatesResponse) GetTotalCount() float64 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-func (x *ListExchangeRatesResponse) GetExchangeRateDatetime() *timestamppb.Timestamp {
+func (x *CompareConversionResponse) GetStats() *ConversionRateStats {
 	if x != nil {
-		return x.ExchangeRateDatetime
+		return x.Stats
 	}
 	return nil
 }
 
-// Currency is the object representing a currency with the code and value of it.
-type Currency struct {
+// ProviderConversion is a conversion converted with an exchange provider.
+type ProviderConversion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// code is the standardised currency code for a specific country.
-	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	// value is the non-negative value of that currency.
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// provider of the rates of the conversion.
+	ExchangeProvider string `protobuf:"bytes,1,opt,name=exchange_provider,json=exchangeProvider,proto3" json:"exchange_provider,omitempty"`
+	// conversion with the rates of the provider, with the rate and its timestamp. Unset when it failed.
+	Conversion *ConversionResponse `protobuf:"bytes,2,opt,name=conversion,proto3" json:"conversion,omitempty"`
+	// status of the conversion, with the code OK when it succeeded.
+	Status *status.Status `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *Currency) Reset() {
-	*x = Currency{}
+func (x *ProviderConversion) Reset() {
+	*x = ProviderConversion{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -436,13 +844,13 @@ func (x *Currency) Reset() {
 	}
 }
 
-func (x *Currency) String() string {
+func (x *ProviderConversion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Currency) ProtoMessage() {}
+func (*ProviderConversion) ProtoMessage() {}
 
-func (x *Currency) ProtoReflect() protoreflect.Message {
+func (x *ProviderConversion) ProtoReflect() protoreflect.Message {
 	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -454,39 +862,57 @@ func (x *Currency) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Currency.ProtoReflect.Descriptor instead.
-func (*Currency) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProviderConversion.ProtoReflect.Descriptor instead.
+func (*ProviderConversion) Descriptor() ([]byte, []int) {
 	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Currency) GetCode() string {
+func (x *ProviderConversion) GetExchangeProvider() string {
 	if x != nil {
-		return x.Code
+		return x.ExchangeProvider
 	}
 	return ""
 }
 
-func (x *Currency) GetValue() string {
+func (x *ProviderConversion) GetConversion() *ConversionResponse {
 	if x != nil {
-		return x.Value
+		return x.Conversion
 	}
-	return ""
+	return nil
 }
 
-// Options to paginate a response using offsets.
-type OffsetPaginationOptions struct {
+func (x *ProviderConversion) GetStatus() *status.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// ConversionRateStats holds the statistics of the mid-market rates of a conversion across the exchange providers,
+// as decimal strings. Unset when no provider converted.
+type ConversionRateStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Optional. Offset at which to start returning records.
-	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
-	// Optional. Number of records to return.
-	Size uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// number of providers which converted.
+	Count uint32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// lowest rate.
+	MinRate string `protobuf:"bytes,2,opt,name=min_rate,json=minRate,proto3" json:"min_rate,omitempty"`
+	// provider of the lowest rate.
+	MinProvider string `protobuf:"bytes,3,opt,name=min_provider,json=minProvider,proto3" json:"min_provider,omitempty"`
+	// highest rate.
+	MaxRate string `protobuf:"bytes,4,opt,name=max_rate,json=maxRate,proto3" json:"max_rate,omitempty"`
+	// provider of the highest rate.
+	MaxProvider string `protobuf:"bytes,5,opt,name=max_provider,json=maxProvider,proto3" json:"max_provider,omitempty"`
+	// median rate, the mean of the two middle rates for an even count.
+	MedianRate string `protobuf:"bytes,6,opt,name=median_rate,json=medianRate,proto3" json:"median_rate,omitempty"`
+	// difference between the highest and the lowest rates, in basis points of the median rate, e.g. "12.5".
+	SpreadBps string `protobuf:"bytes,7,opt,name=spread_bps,json=spreadBps,proto3" json:"spread_bps,omitempty"`
 }
 
-func (x *OffsetPaginationOptions) Reset() {
-	*x = OffsetPaginationOptions{}
+func (x *ConversionRateStats) Reset() {
+	*x = ConversionRateStats{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -494,13 +920,13 @@ func (x *OffsetPaginationOptions) Reset() {
 	}
 }
 
-func (x *OffsetPaginationOptions) String() string {
+func (x *ConversionRateStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OffsetPaginationOptions) ProtoMessage() {}
+func (*ConversionRateStats) ProtoMessage() {}
 
-func (x *OffsetPaginationOptions) ProtoReflect() protoreflect.Message {
+func (x *ConversionRateStats) ProtoReflect() protoreflect.Message {
 	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -512,270 +938,3791 @@ func (x *OffsetPaginationOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OffsetPaginationOptions.ProtoReflect.Descriptor instead.
-func (*OffsetPaginationOptions) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConversionRateStats.ProtoReflect.Descriptor instead.
+func (*ConversionRateStats) Descriptor() ([]byte, []int) {
 	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *OffsetPaginationOptions) GetOffset() uint64 {
+func (x *ConversionRateStats) GetCount() uint32 {
 	if x != nil {
-		return x.Offset
+		return x.Count
 	}
 	return 0
 }
 
-func (x *OffsetPaginationOptions) GetSize() uint64 {
+func (x *ConversionRateStats) GetMinRate() string {
 	if x != nil {
-		return x.Size
+		return x.MinRate
 	}
-	return 0
+	return ""
 }
 
-var File_v1alpha1_currencyconverter_currency_converter_server_proto protoreflect.FileDescriptor
+func (x *ConversionRateStats) GetMinProvider() string {
+	if x != nil {
+		return x.MinProvider
+	}
+	return ""
+}
 
-var file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDesc = []byte{
-	0x0a, 0x3a, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x79, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2f, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x25, 0x61, 0x70,
-	0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
-	0x74, 0x65, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x9f, 0x01, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x04, 0x66, 0x72, 0x6f,
-	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
-	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x66,
-	0x72, 0x6f, 0x6d, 0x12, 0x13, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x03, 0xe0, 0x41, 0x02, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0xec, 0x02, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x09,
-	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f,
-	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x12, 0x43, 0x0a, 0x04, 0x66,
-	0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
-	0x72, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
-	0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x4b, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12,
-	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69,
-	0x6d, 0x65, 0x12, 0x50, 0x0a, 0x16, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72,
-	0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x14,
-	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65,
-	0x74, 0x69, 0x6d, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f,
-	0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x5d, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x03, 0xe0,
-	0x41, 0x02, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x1f,
-	0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22,
-	0x74, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0a, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e,
-	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x69, 0x65, 0x73, 0x22, 0xdc, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x63, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x4f,
-	0x66, 0x66, 0x73, 0x65, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xdf, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x50, 0x0a, 0x16, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x14, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x44, 0x61,
-	0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x34, 0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x45, 0x0a, 0x17,
-	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x32, 0xb7, 0x04, 0x0a, 0x18, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0xa2, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x12, 0x38, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
-	0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43,
-	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2f, 0x63, 0x6f,
-	0x6e, 0x76, 0x65, 0x72, 0x74, 0x12, 0xba, 0x01, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
-	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x12, 0x3d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x20, 0x2f,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x3a,
-	0x01, 0x2a, 0x12, 0xb8, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x3f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
-	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61,
-	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x42, 0x26, 0x5a,
-	0x24, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x63, 0x6f, 0x6e, 0x76,
-	0x65, 0x72, 0x74, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *ConversionRateStats) GetMaxRate() string {
+	if x != nil {
+		return x.MaxRate
+	}
+	return ""
 }
 
-var (
-	file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescOnce sync.Once
-	file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescData = file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDesc
-)
+func (x *ConversionRateStats) GetMaxProvider() string {
+	if x != nil {
+		return x.MaxProvider
+	}
+	return ""
+}
 
-func file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP() []byte {
-	file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescOnce.Do(func() {
-		file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescData = protoimpl.X.CompressGZIP(file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescData)
-	})
-	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescData
+func (x *ConversionRateStats) GetMedianRate() string {
+	if x != nil {
+		return x.MedianRate
+	}
+	return ""
 }
 
-var file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
-var file_v1alpha1_currencyconverter_currency_converter_server_proto_goTypes = []interface{}{
-	(*ConversionRequest)(nil),         // 0: api.proto.v1alpha1.currency.converter.ConversionRequest
-	(*ConversionResponse)(nil),        // 1: api.proto.v1alpha1.currency.converter.ConversionResponse
-	(*BatchConversionRequest)(nil),    // 2: api.proto.v1alpha1.currency.converter.BatchConversionRequest
-	(*BatchConversionResponse)(nil),   // 3: api.proto.v1alpha1.currency.converter.BatchConversionResponse
-	(*ListExchangeRatesRequest)(nil),  // 4: api.proto.v1alpha1.currency.converter.ListExchangeRatesRequest
-	(*ListExchangeRatesResponse)(nil), // 5: api.proto.v1alpha1.currency.converter.ListExchangeRatesResponse
-	(*Currency)(nil),                  // 6: api.proto.v1alpha1.currency.converter.Currency
-	(*OffsetPaginationOptions)(nil),   // 7: api.proto.v1alpha1.currency.converter.OffsetPaginationOptions
-	(*timestamppb.Timestamp)(nil),     // 8: google.protobuf.Timestamp
+func (x *ConversionRateStats) GetSpreadBps() string {
+	if x != nil {
+		return x.SpreadBps
+	}
+	return ""
 }
-var file_v1alpha1_currencyconverter_currency_converter_server_proto_depIdxs = []int32{
-	6,  // 0: api.proto.v1alpha1.currency.converter.ConversionRequest.from:type_name -> api.proto.v1alpha1.currency.converter.Currency
-	6,  // 1: api.proto.v1alpha1.currency.converter.ConversionResponse.converted:type_name -> api.proto.v1alpha1.currency.converter.Currency
-	6,  // 2: api.proto.v1alpha1.currency.converter.ConversionResponse.from:type_name -> api.proto.v1alpha1.currency.converter.Currency
-	8,  // 3: api.proto.v1alpha1.currency.converter.ConversionResponse.conversion_datetime:type_name -> google.protobuf.Timestamp
-	8,  // 4: api.proto.v1alpha1.currency.converter.ConversionResponse.exchange_rate_datetime:type_name -> google.protobuf.Timestamp
-	0,  // 5: api.proto.v1alpha1.currency.converter.BatchConversionRequest.currencies:type_name -> api.proto.v1alpha1.currency.converter.ConversionRequest
-	1,  // 6: api.proto.v1alpha1.currency.converter.BatchConversionResponse.currencies:type_name -> api.proto.v1alpha1.currency.converter.ConversionResponse
-	7,  // 7: api.proto.v1alpha1.currency.converter.ListExchangeRatesRequest.pagination:type_name -> api.proto.v1alpha1.currency.converter.OffsetPaginationOptions
-	6,  // 8: api.proto.v1alpha1.currency.converter.ListExchangeRatesResponse.currencies:type_name -> api.proto.v1alpha1.currency.converter.Currency
-	8,  // 9: api.proto.v1alpha1.currency.converter.ListExchangeRatesResponse.exchange_rate_datetime:type_name -> google.protobuf.Timestamp
-	0,  // 10: api.proto.v1alpha1.currency.converter.CurrencyConverterService.Convert:input_type -> api.proto.v1alpha1.currency.converter.ConversionRequest
-	2,  // 11: api.proto.v1alpha1.currency.converter.CurrencyConverterService.BatchConvert:input_type -> api.proto.v1alpha1.currency.converter.BatchConversionRequest
-	4,  // 12: api.proto.v1alpha1.currency.converter.CurrencyConverterService.ListExchangeRates:input_type -> api.proto.v1alpha1.currency.converter.ListExchangeRatesRequest
-	1,  // 13: api.proto.v1alpha1.currency.converter.CurrencyConverterService.Convert:output_type -> api.proto.v1alpha1.currency.converter.ConversionResponse
-	3,  // 14: api.proto.v1alpha1.currency.converter.CurrencyConverterService.BatchConvert:output_type -> api.proto.v1alpha1.currency.converter.BatchConversionResponse
-	5,  // 15: api.proto.v1alpha1.currency.converter.CurrencyConverterService.ListExchangeRates:output_type -> api.proto.v1alpha1.currency.converter.ListExchangeRatesResponse
-	13, // [13:16] is the sub-list for method output_type
-	10, // [10:13] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+
+// request to lock the exchange rate of a currency pair.
+type CreateQuoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// from is the currency code to be converted.
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the currency code we want to convert it to.
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Optional. provider to be used for exchange rates. [default: CurrencyLayer]
+	ExchangeProvider string `protobuf:"bytes,3,opt,name=exchange_provider,json=exchangeProvider,proto3" json:"exchange_provider,omitempty"`
 }
 
-func init() { file_v1alpha1_currencyconverter_currency_converter_server_proto_init() }
-func file_v1alpha1_currencyconverter_currency_converter_server_proto_init() {
-	if File_v1alpha1_currencyconverter_currency_converter_server_proto != nil {
-		return
+func (x *CreateQuoteRequest) Reset() {
+	*x = CreateQuoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConversionRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConversionResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchConversionRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+}
+
+func (x *CreateQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuoteRequest) ProtoMessage() {}
+
+func (x *CreateQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchConversionResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuoteRequest.ProtoReflect.Descriptor instead.
+func (*CreateQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateQuoteRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *CreateQuoteRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *CreateQuoteRequest) GetExchangeProvider() string {
+	if x != nil {
+		return x.ExchangeProvider
+	}
+	return ""
+}
+
+// Quote is an exchange rate locked until it expires or is used.
+type Quote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the quote, to convert with it.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// currency code the rate converts from.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// currency code the rate converts to.
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// provider of the rate.
+	ExchangeProvider string `protobuf:"bytes,4,opt,name=exchange_provider,json=exchangeProvider,proto3" json:"exchange_provider,omitempty"`
+	// locked rate the amounts are converted with, as a decimal string.
+	// With a pricing rule, the spread is taken off and the fees are charged on conversion.
+	ExchangeRate string `protobuf:"bytes,5,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	// locked mid-market rate, as a decimal string.
+	MidRate string `protobuf:"bytes,6,opt,name=mid_rate,json=midRate,proto3" json:"mid_rate,omitempty"`
+	// currencies the rate was derived through, from the source to the target currency.
+	ConversionPath []string `protobuf:"bytes,7,rep,name=conversion_path,json=conversionPath,proto3" json:"conversion_path,omitempty"`
+	// timestamp at which the exchange rate was taken from.
+	ExchangeRateDatetime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=exchange_rate_datetime,json=exchangeRateDatetime,proto3" json:"exchange_rate_datetime,omitempty"`
+	// timestamp at which the quote was created.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// timestamp from which the quote is rejected.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+}
+
+func (x *Quote) Reset() {
+	*x = Quote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Quote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quote) ProtoMessage() {}
+
+func (x *Quote) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quote.ProtoReflect.Descriptor instead.
+func (*Quote) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Quote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Quote) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Quote) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *Quote) GetExchangeProvider() string {
+	if x != nil {
+		return x.ExchangeProvider
+	}
+	return ""
+}
+
+func (x *Quote) GetExchangeRate() string {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return ""
+}
+
+func (x *Quote) GetMidRate() string {
+	if x != nil {
+		return x.MidRate
+	}
+	return ""
+}
+
+func (x *Quote) GetConversionPath() []string {
+	if x != nil {
+		return x.ConversionPath
+	}
+	return nil
+}
+
+func (x *Quote) GetExchangeRateDatetime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExchangeRateDatetime
+	}
+	return nil
+}
+
+func (x *Quote) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Quote) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+// request to convert with the rate locked by a quote.
+type ConvertWithQuoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the quote.
+	QuoteId string `protobuf:"bytes,1,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	// from is the amount to be converted, its code must be the currency of the quote.
+	From *Currency `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// Optional. rounding mode of the converted value. [default: configured per deployment]
+	RoundingMode RoundingMode `protobuf:"varint,3,opt,name=rounding_mode,json=roundingMode,proto3,enum=api.proto.v1alpha1.currency.converter.RoundingMode" json:"rounding_mode,omitempty"`
+	// Optional. BCP 47 locale from.value is written in, e.g. "de-DE" for "1.234,56 €".
+	Locale string `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *ConvertWithQuoteRequest) Reset() {
+	*x = ConvertWithQuoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConvertWithQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertWithQuoteRequest) ProtoMessage() {}
+
+func (x *ConvertWithQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertWithQuoteRequest.ProtoReflect.Descriptor instead.
+func (*ConvertWithQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ConvertWithQuoteRequest) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *ConvertWithQuoteRequest) GetFrom() *Currency {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ConvertWithQuoteRequest) GetRoundingMode() RoundingMode {
+	if x != nil {
+		return x.RoundingMode
+	}
+	return RoundingMode_ROUNDING_MODE_UNSPECIFIED
+}
+
+func (x *ConvertWithQuoteRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// ConvertToManyRequest represents the request to convert one amount to several currencies.
+type ConvertToManyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// from is the amount to be converted.
+	From *Currency `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// to are the currency codes the amount is converted to.
+	To []string `protobuf:"bytes,2,rep,name=to,proto3" json:"to,omitempty"`
+	// Optional. provider to be used for exchange rates of all the conversions. [default: configured per deployment]
+	ExchangeProvider string `protobuf:"bytes,3,opt,name=exchange_provider,json=exchangeProvider,proto3" json:"exchange_provider,omitempty"`
+	// Optional. rounding mode of the converted values. [default: configured per deployment]
+	RoundingMode RoundingMode `protobuf:"varint,4,opt,name=rounding_mode,json=roundingMode,proto3,enum=api.proto.v1alpha1.currency.converter.RoundingMode" json:"rounding_mode,omitempty"`
+	// Optional. BCP 47 locale from.value is written in, the converted values are also returned formatted in it.
+	Locale string `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *ConvertToManyRequest) Reset() {
+	*x = ConvertToManyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConvertToManyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertToManyRequest) ProtoMessage() {}
+
+func (x *ConvertToManyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertToManyRequest.ProtoReflect.Descriptor instead.
+func (*ConvertToManyRequest) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ConvertToManyRequest) GetFrom() *Currency {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ConvertToManyRequest) GetTo() []string {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ConvertToManyRequest) GetExchangeProvider() string {
+	if x != nil {
+		return x.ExchangeProvider
+	}
+	return ""
+}
+
+func (x *ConvertToManyRequest) GetRoundingMode() RoundingMode {
+	if x != nil {
+		return x.RoundingMode
+	}
+	return RoundingMode_ROUNDING_MODE_UNSPECIFIED
+}
+
+func (x *ConvertToManyRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// ConvertToManyResponse represents the conversions of one amount to several currencies.
+type ConvertToManyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// result of the conversion to every currency of the request, in the same order.
+	Results []*BatchConversionResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ConvertToManyResponse) Reset() {
+	*x = ConvertToManyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConvertToManyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertToManyResponse) ProtoMessage() {}
+
+func (x *ConvertToManyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertToManyResponse.ProtoReflect.Descriptor instead.
+func (*ConvertToManyResponse) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ConvertToManyResponse) GetResults() []*BatchConversionResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// ConvertBasketRequest represents the request to convert amounts in several currencies to one currency, and sum them.
+type ConvertBasketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// amounts to be converted and summed, a currency may have several amounts.
+	Amounts []*Currency `protobuf:"bytes,1,rep,name=amounts,proto3" json:"amounts,omitempty"`
+	// to is the currency code the amounts are converted to.
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Optional. provider to be used for exchange rates of all the amounts. [default: configured per deployment]
+	ExchangeProvider string `protobuf:"bytes,3,opt,name=exchange_provider,json=exchangeProvider,proto3" json:"exchange_provider,omitempty"`
+	// Optional. rounding mode of the converted amounts and of the total. [default: configured per deployment]
+	RoundingMode RoundingMode `protobuf:"varint,4,opt,name=rounding_mode,json=roundingMode,proto3,enum=api.proto.v1alpha1.currency.converter.RoundingMode" json:"rounding_mode,omitempty"`
+	// Optional. BCP 47 locale the amounts are written in, the converted values are also returned formatted in it.
+	Locale string `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *ConvertBasketRequest) Reset() {
+	*x = ConvertBasketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConvertBasketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertBasketRequest) ProtoMessage() {}
+
+func (x *ConvertBasketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertBasketRequest.ProtoReflect.Descriptor instead.
+func (*ConvertBasketRequest) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ConvertBasketRequest) GetAmounts() []*Currency {
+	if x != nil {
+		return x.Amounts
+	}
+	return nil
+}
+
+func (x *ConvertBasketRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ConvertBasketRequest) GetExchangeProvider() string {
+	if x != nil {
+		return x.ExchangeProvider
+	}
+	return ""
+}
+
+func (x *ConvertBasketRequest) GetRoundingMode() RoundingMode {
+	if x != nil {
+		return x.RoundingMode
+	}
+	return RoundingMode_ROUNDING_MODE_UNSPECIFIED
+}
+
+func (x *ConvertBasketRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// ConvertBasketResponse represents the total of the amounts of a basket, converted to one currency.
+type ConvertBasketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// conversion of every amount of the request, in the same order, with the mid-market rates.
+	// The pricing rules do not apply to a basket, the legs have no fees.
+	Legs []*ConversionResponse `protobuf:"bytes,1,rep,name=legs,proto3" json:"legs,omitempty"`
+	// total is the sum of the exact converted amounts, rounded once. It can differ from the sum of the rounded legs.
+	Total *Currency `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	// rounding mode applied to the legs and to the total.
+	RoundingMode RoundingMode `protobuf:"varint,3,opt,name=rounding_mode,json=roundingMode,proto3,enum=api.proto.v1alpha1.currency.converter.RoundingMode" json:"rounding_mode,omitempty"`
+	// time at which the oldest rates used were fetched.
+	ExchangeRateDatetime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=exchange_rate_datetime,json=exchangeRateDatetime,proto3" json:"exchange_rate_datetime,omitempty"`
+	ConversionDatetime   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=conversion_datetime,json=conversionDatetime,proto3" json:"conversion_datetime,omitempty"`
+}
+
+func (x *ConvertBasketResponse) Reset() {
+	*x = ConvertBasketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConvertBasketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertBasketResponse) ProtoMessage() {}
+
+func (x *ConvertBasketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertBasketResponse.ProtoReflect.Descriptor instead.
+func (*ConvertBasketResponse) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ConvertBasketResponse) GetLegs() []*ConversionResponse {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+func (x *ConvertBasketResponse) GetTotal() *Currency {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *ConvertBasketResponse) GetRoundingMode() RoundingMode {
+	if x != nil {
+		return x.RoundingMode
+	}
+	return RoundingMode_ROUNDING_MODE_UNSPECIFIED
+}
+
+func (x *ConvertBasketResponse) GetExchangeRateDatetime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExchangeRateDatetime
+	}
+	return nil
+}
+
+func (x *ConvertBasketResponse) GetConversionDatetime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConversionDatetime
+	}
+	return nil
+}
+
+// BatchConversionRequest represents the request to convert currencies in batch.
+type BatchConversionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List/Array of currencies to be requested for conversions.
+	Currencies []*ConversionRequest `protobuf:"bytes,1,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	// Optional. (internal) limit of a batch size allowed, at most the maximum batch size of the deployment.
+	// [default: the maximum batch size of the deployment]
+	BatchLimit uint64 `protobuf:"varint,2,opt,name=batch_limit,json=batchLimit,proto3" json:"batch_limit,omitempty"`
+	// Optional. rounding mode of the conversions which do not specify one. [default: configured per deployment]
+	RoundingMode RoundingMode `protobuf:"varint,3,opt,name=rounding_mode,json=roundingMode,proto3,enum=api.proto.v1alpha1.currency.converter.RoundingMode" json:"rounding_mode,omitempty"`
+}
+
+func (x *BatchConversionRequest) Reset() {
+	*x = BatchConversionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchConversionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchConversionRequest) ProtoMessage() {}
+
+func (x *BatchConversionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchConversionRequest.ProtoReflect.Descriptor instead.
+func (*BatchConversionRequest) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BatchConversionRequest) GetCurrencies() []*ConversionRequest {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+func (x *BatchConversionRequest) GetBatchLimit() uint64 {
+	if x != nil {
+		return x.BatchLimit
+	}
+	return 0
+}
+
+func (x *BatchConversionRequest) GetRoundingMode() RoundingMode {
+	if x != nil {
+		return x.RoundingMode
+	}
+	return RoundingMode_ROUNDING_MODE_UNSPECIFIED
+}
+
+// BatchConversionResponse represents the response to convert currencies in batch.
+type BatchConversionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// result of every conversion of the request, in the same order.
+	Results []*BatchConversionResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchConversionResponse) Reset() {
+	*x = BatchConversionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchConversionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchConversionResponse) ProtoMessage() {}
+
+func (x *BatchConversionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchConversionResponse.ProtoReflect.Descriptor instead.
+func (*BatchConversionResponse) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BatchConversionResponse) GetResults() []*BatchConversionResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// BatchConversionResult is the outcome of a conversion of a batch.
+type BatchConversionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// conversion of the request at the same index. Unset when it failed.
+	Conversion *ConversionResponse `protobuf:"bytes,1,opt,name=conversion,proto3" json:"conversion,omitempty"`
+	// status of the conversion, with the code OK when it succeeded.
+	Status *status.Status `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *BatchConversionResult) Reset() {
+	*x = BatchConversionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchConversionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchConversionResult) ProtoMessage() {}
+
+func (x *BatchConversionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchConversionResult.ProtoReflect.Descriptor instead.
+func (*BatchConversionResult) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BatchConversionResult) GetConversion() *ConversionResponse {
+	if x != nil {
+		return x.Conversion
+	}
+	return nil
+}
+
+func (x *BatchConversionResult) GetStatus() *status.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// ConvertStreamRequest is a conversion of a stream.
+type ConvertStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the conversion, chosen by the client to match the response with the request.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// conversion to be converted.
+	Conversion *ConversionRequest `protobuf:"bytes,2,opt,name=conversion,proto3" json:"conversion,omitempty"`
+}
+
+func (x *ConvertStreamRequest) Reset() {
+	*x = ConvertStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConvertStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertStreamRequest) ProtoMessage() {}
+
+func (x *ConvertStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertStreamRequest.ProtoReflect.Descriptor instead.
+func (*ConvertStreamRequest) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ConvertStreamRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ConvertStreamRequest) GetConversion() *ConversionRequest {
+	if x != nil {
+		return x.Conversion
+	}
+	return nil
+}
+
+// ConvertStreamResponse is the outcome of a conversion of a stream.
+type ConvertStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the request of the conversion.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// conversion of the request. Unset when it failed.
+	Conversion *ConversionResponse `protobuf:"bytes,2,opt,name=conversion,proto3" json:"conversion,omitempty"`
+	// status of the conversion, with the code OK when it succeeded.
+	Status *status.Status `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ConvertStreamResponse) Reset() {
+	*x = ConvertStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConvertStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertStreamResponse) ProtoMessage() {}
+
+func (x *ConvertStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertStreamResponse.ProtoReflect.Descriptor instead.
+func (*ConvertStreamResponse) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ConvertStreamResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ConvertStreamResponse) GetConversion() *ConversionResponse {
+	if x != nil {
+		return x.Conversion
+	}
+	return nil
+}
+
+func (x *ConvertStreamResponse) GetStatus() *status.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// request for exchange rates for the supported currencies.
+type ListExchangeRatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *OffsetPaginationOptions `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Optional. provider to be used for exchange rates. [default: CurrencyLayer]
+	ExchangeProvider string `protobuf:"bytes,2,opt,name=exchange_provider,json=exchangeProvider,proto3" json:"exchange_provider,omitempty"`
+	// Include total count of exchange rates.
+	IncludeTotalCount bool `protobuf:"varint,3,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"`
+	// Optional. BCP 47 locale the rates are also returned formatted in, e.g. "de-DE".
+	Locale string `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Optional. currency code the rates are quoted against. [default: USD]
+	Base string `protobuf:"bytes,5,opt,name=base,proto3" json:"base,omitempty"`
+	// Optional. currency codes to list the rates of. [default: all the currencies of the provider]
+	Codes []string `protobuf:"bytes,6,rep,name=codes,proto3" json:"codes,omitempty"`
+	// Optional. next_page_token of the previous page, to list the next page from the same rates.
+	// The pagination offset is ignored with a page token, the size still applies.
+	PageToken string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListExchangeRatesRequest) Reset() {
+	*x = ListExchangeRatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesRequest) ProtoMessage() {}
+
+func (x *ListExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListExchangeRatesRequest) GetPagination() *OffsetPaginationOptions {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListExchangeRatesRequest) GetExchangeProvider() string {
+	if x != nil {
+		return x.ExchangeProvider
+	}
+	return ""
+}
+
+func (x *ListExchangeRatesRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+func (x *ListExchangeRatesRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *ListExchangeRatesRequest) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *ListExchangeRatesRequest) GetCodes() []string {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+func (x *ListExchangeRatesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// response with the list of exchange rates for the supported currencies.
+type ListExchangeRatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of currencies and their current rate, ordered by code.
+	Currencies []*Currency `protobuf:"bytes,1,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	// total count of the rates of all the pages. Set when include_total_count is requested.
+	TotalCount float64 `protobuf:"fixed64,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// timestamp at which the exchange rate was taken from.
+	ExchangeRateDatetime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=exchange_rate_datetime,json=exchangeRateDatetime,proto3" json:"exchange_rate_datetime,omitempty"`
+	// currency code the rates are quoted against, e.g. USD.
+	Base string `protobuf:"bytes,4,opt,name=base,proto3" json:"base,omitempty"`
+	// token of the next page, listed from the same rates as this page. Empty on the last page.
+	// It expires with the rates, and is then rejected.
+	NextPageToken string `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListExchangeRatesResponse) Reset() {
+	*x = ListExchangeRatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesResponse) ProtoMessage() {}
+
+func (x *ListExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListExchangeRatesResponse) GetCurrencies() []*Currency {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+func (x *ListExchangeRatesResponse) GetTotalCount() float64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListExchangeRatesResponse) GetExchangeRateDatetime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExchangeRateDatetime
+	}
+	return nil
+}
+
+func (x *ListExchangeRatesResponse) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *ListExchangeRatesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// request for the exchange rates of a past date.
+type HistoricalRatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// date of the rates, as YYYY-MM-DD in UTC, e.g. "2022-03-14". It must not be in the future.
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Optional. provider to be used for exchange rates. [default: CurrencyLayer]
+	ExchangeProvider string `protobuf:"bytes,2,opt,name=exchange_provider,json=exchangeProvider,proto3" json:"exchange_provider,omitempty"`
+	// Optional. currency code the rates are quoted against. [default: USD]
+	Base string `protobuf:"bytes,3,opt,name=base,proto3" json:"base,omitempty"`
+	// Optional. currency codes to list the rates of. [default: all the currencies of the provider that day]
+	Codes []string `protobuf:"bytes,4,rep,name=codes,proto3" json:"codes,omitempty"`
+	// Optional. BCP 47 locale the rates are also returned formatted in, e.g. "de-DE".
+	Locale string `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *HistoricalRatesRequest) Reset() {
+	*x = HistoricalRatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistoricalRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoricalRatesRequest) ProtoMessage() {}
+
+func (x *HistoricalRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoricalRatesRequest.ProtoReflect.Descriptor instead.
+func (*HistoricalRatesRequest) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *HistoricalRatesRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *HistoricalRatesRequest) GetExchangeProvider() string {
+	if x != nil {
+		return x.ExchangeProvider
+	}
+	return ""
+}
+
+func (x *HistoricalRatesRequest) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *HistoricalRatesRequest) GetCodes() []string {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+func (x *HistoricalRatesRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// response with the exchange rates of a past date.
+type HistoricalRatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// date of the rates, as YYYY-MM-DD in UTC.
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// currency code the rates are quoted against, e.g. USD.
+	Base string `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	// List of currencies and their rate that day, ordered by code.
+	Currencies []*Currency `protobuf:"bytes,3,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	// timestamp at which the exchange rate was taken from.
+	ExchangeRateDatetime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=exchange_rate_datetime,json=exchangeRateDatetime,proto3" json:"exchange_rate_datetime,omitempty"`
+	// where the rates were read from.
+	Source HistoricalRatesSource `protobuf:"varint,5,opt,name=source,proto3,enum=api.proto.v1alpha1.currency.converter.HistoricalRatesSource" json:"source,omitempty"`
+}
+
+func (x *HistoricalRatesResponse) Reset() {
+	*x = HistoricalRatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistoricalRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoricalRatesResponse) ProtoMessage() {}
+
+func (x *HistoricalRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoricalRatesResponse.ProtoReflect.Descriptor instead.
+func (*HistoricalRatesResponse) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *HistoricalRatesResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *HistoricalRatesResponse) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *HistoricalRatesResponse) GetCurrencies() []*Currency {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+func (x *HistoricalRatesResponse) GetExchangeRateDatetime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExchangeRateDatetime
+	}
+	return nil
+}
+
+func (x *HistoricalRatesResponse) GetSource() HistoricalRatesSource {
+	if x != nil {
+		return x.Source
+	}
+	return HistoricalRatesSource_HISTORICAL_RATES_SOURCE_UNSPECIFIED
+}
+
+// request for the exchange rates of a currency pair over a time range.
+type RateTimeSeriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// from is the currency code the rates convert from.
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the currency code the rates convert to.
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// start of the range, inclusive. It is truncated to the start of its day or hour.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end of the range, exclusive. The range is at most 366 days by day, and 31 days by hour.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Optional. period the rates are aggregated by. [default: TIME_SERIES_GRANULARITY_DAY]
+	Granularity TimeSeriesGranularity `protobuf:"varint,5,opt,name=granularity,proto3,enum=api.proto.v1alpha1.currency.converter.TimeSeriesGranularity" json:"granularity,omitempty"`
+	// Optional. provider to be used for exchange rates. [default: CurrencyLayer]
+	ExchangeProvider string `protobuf:"bytes,6,opt,name=exchange_provider,json=exchangeProvider,proto3" json:"exchange_provider,omitempty"`
+	// Optional. also return the open, high, low and close rates of every period.
+	IncludeCandles bool `protobuf:"varint,7,opt,name=include_candles,json=includeCandles,proto3" json:"include_candles,omitempty"`
+	// Optional. number of periods of a page, at most 1000. [default: 100]
+	PageSize uint64 `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. next_page_token of the previous page, to get the next periods of the same series.
+	PageToken string `protobuf:"bytes,9,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *RateTimeSeriesRequest) Reset() {
+	*x = RateTimeSeriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateTimeSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateTimeSeriesRequest) ProtoMessage() {}
+
+func (x *RateTimeSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateTimeSeriesRequest.ProtoReflect.Descriptor instead.
+func (*RateTimeSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RateTimeSeriesRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *RateTimeSeriesRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *RateTimeSeriesRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *RateTimeSeriesRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *RateTimeSeriesRequest) GetGranularity() TimeSeriesGranularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return TimeSeriesGranularity_TIME_SERIES_GRANULARITY_UNSPECIFIED
+}
+
+func (x *RateTimeSeriesRequest) GetExchangeProvider() string {
+	if x != nil {
+		return x.ExchangeProvider
+	}
+	return ""
+}
+
+func (x *RateTimeSeriesRequest) GetIncludeCandles() bool {
+	if x != nil {
+		return x.IncludeCandles
+	}
+	return false
+}
+
+func (x *RateTimeSeriesRequest) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *RateTimeSeriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// response with the exchange rates of a currency pair over a time range.
+type RateTimeSeriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// currency code the rates convert from.
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// currency code the rates convert to.
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// period the rates are aggregated by.
+	Granularity TimeSeriesGranularity `protobuf:"varint,3,opt,name=granularity,proto3,enum=api.proto.v1alpha1.currency.converter.TimeSeriesGranularity" json:"granularity,omitempty"`
+	// rate of every period of the page with rates, ordered by time.
+	Points []*RatePoint `protobuf:"bytes,4,rep,name=points,proto3" json:"points,omitempty"`
+	// open, high, low and close rates of every period of points. Set when include_candles is requested.
+	Candles []*RateCandle `protobuf:"bytes,5,rep,name=candles,proto3" json:"candles,omitempty"`
+	// token of the next periods. Empty on the last page.
+	NextPageToken string `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *RateTimeSeriesResponse) Reset() {
+	*x = RateTimeSeriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateTimeSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateTimeSeriesResponse) ProtoMessage() {}
+
+func (x *RateTimeSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateTimeSeriesResponse.ProtoReflect.Descriptor instead.
+func (*RateTimeSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RateTimeSeriesResponse) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *RateTimeSeriesResponse) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *RateTimeSeriesResponse) GetGranularity() TimeSeriesGranularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return TimeSeriesGranularity_TIME_SERIES_GRANULARITY_UNSPECIFIED
+}
+
+func (x *RateTimeSeriesResponse) GetPoints() []*RatePoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *RateTimeSeriesResponse) GetCandles() []*RateCandle {
+	if x != nil {
+		return x.Candles
+	}
+	return nil
+}
+
+func (x *RateTimeSeriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// RatePoint is the rate of a period of a time series.
+type RatePoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// start of the period.
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// last rate of the period, as a decimal string.
+	Rate string `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
+}
+
+func (x *RatePoint) Reset() {
+	*x = RatePoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RatePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatePoint) ProtoMessage() {}
+
+func (x *RatePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatePoint.ProtoReflect.Descriptor instead.
+func (*RatePoint) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RatePoint) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *RatePoint) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+// RateCandle holds the open, high, low and close rates of a period of a time series, as decimal strings.
+type RateCandle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// start of the period.
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// first rate of the period.
+	Open string `protobuf:"bytes,2,opt,name=open,proto3" json:"open,omitempty"`
+	// highest rate of the period.
+	High string `protobuf:"bytes,3,opt,name=high,proto3" json:"high,omitempty"`
+	// lowest rate of the period.
+	Low string `protobuf:"bytes,4,opt,name=low,proto3" json:"low,omitempty"`
+	// last rate of the period.
+	Close string `protobuf:"bytes,5,opt,name=close,proto3" json:"close,omitempty"`
+	// number of refreshes of the rates during the period, the last one of every base currency by day.
+	Samples uint32 `protobuf:"varint,6,opt,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (x *RateCandle) Reset() {
+	*x = RateCandle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateCandle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateCandle) ProtoMessage() {}
+
+func (x *RateCandle) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateCandle.ProtoReflect.Descriptor instead.
+func (*RateCandle) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RateCandle) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *RateCandle) GetOpen() string {
+	if x != nil {
+		return x.Open
+	}
+	return ""
+}
+
+func (x *RateCandle) GetHigh() string {
+	if x != nil {
+		return x.High
+	}
+	return ""
+}
+
+func (x *RateCandle) GetLow() string {
+	if x != nil {
+		return x.Low
+	}
+	return ""
+}
+
+func (x *RateCandle) GetClose() string {
+	if x != nil {
+		return x.Close
+	}
+	return ""
+}
+
+func (x *RateCandle) GetSamples() uint32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+// request to watch the exchange rates.
+type WatchExchangeRatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional. providers to watch the exchange rates of. [default: CurrencyLayer]
+	ExchangeProviders []string `protobuf:"bytes,1,rep,name=exchange_providers,json=exchangeProviders,proto3" json:"exchange_providers,omitempty"`
+	// Optional. currency code the rates are quoted against. [default: USD]
+	Base string `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	// Optional. currency codes to watch the rates of. [default: all the currencies of the providers]
+	Codes []string `protobuf:"bytes,3,rep,name=codes,proto3" json:"codes,omitempty"`
+	// Optional. last version received before a reconnection, to only get the rates which changed since.
+	// The versions are tokens of a boot of the service: a full snapshot is sent when the version is unknown,
+	// e.g. after a restart of the service, even if it has since issued the same version number. [default: a full snapshot]
+	ResumeFromVersion uint64 `protobuf:"varint,4,opt,name=resume_from_version,json=resumeFromVersion,proto3" json:"resume_from_version,omitempty"`
+}
+
+func (x *WatchExchangeRatesRequest) Reset() {
+	*x = WatchExchangeRatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchExchangeRatesRequest) ProtoMessage() {}
+
+func (x *WatchExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*WatchExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *WatchExchangeRatesRequest) GetExchangeProviders() []string {
+	if x != nil {
+		return x.ExchangeProviders
+	}
+	return nil
+}
+
+func (x *WatchExchangeRatesRequest) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *WatchExchangeRatesRequest) GetCodes() []string {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+func (x *WatchExchangeRatesRequest) GetResumeFromVersion() uint64 {
+	if x != nil {
+		return x.ResumeFromVersion
+	}
+	return 0
+}
+
+// response of a watch of the exchange rates, one event of the stream.
+type WatchExchangeRatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*WatchExchangeRatesResponse_Snapshot
+	//	*WatchExchangeRatesResponse_Update
+	//	*WatchExchangeRatesResponse_Heartbeat
+	Event isWatchExchangeRatesResponse_Event `protobuf_oneof:"event"`
+}
+
+func (x *WatchExchangeRatesResponse) Reset() {
+	*x = WatchExchangeRatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchExchangeRatesResponse) ProtoMessage() {}
+
+func (x *WatchExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*WatchExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{29}
+}
+
+func (m *WatchExchangeRatesResponse) GetEvent() isWatchExchangeRatesResponse_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *WatchExchangeRatesResponse) GetSnapshot() *RatesSnapshot {
+	if x, ok := x.GetEvent().(*WatchExchangeRatesResponse_Snapshot); ok {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *WatchExchangeRatesResponse) GetUpdate() *ProviderRates {
+	if x, ok := x.GetEvent().(*WatchExchangeRatesResponse_Update); ok {
+		return x.Update
+	}
+	return nil
+}
+
+func (x *WatchExchangeRatesResponse) GetHeartbeat() *Heartbeat {
+	if x, ok := x.GetEvent().(*WatchExchangeRatesResponse_Heartbeat); ok {
+		return x.Heartbeat
+	}
+	return nil
+}
+
+type isWatchExchangeRatesResponse_Event interface {
+	isWatchExchangeRatesResponse_Event()
+}
+
+type WatchExchangeRatesResponse_Snapshot struct {
+	// rates of all the watched providers, sent first.
+	Snapshot *RatesSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3,oneof"`
+}
+
+type WatchExchangeRatesResponse_Update struct {
+	// rates of a provider which changed.
+	Update *ProviderRates `protobuf:"bytes,2,opt,name=update,proto3,oneof"`
+}
+
+type WatchExchangeRatesResponse_Heartbeat struct {
+	// sent when nothing changed for a while.
+	Heartbeat *Heartbeat `protobuf:"bytes,3,opt,name=heartbeat,proto3,oneof"`
+}
+
+func (*WatchExchangeRatesResponse_Snapshot) isWatchExchangeRatesResponse_Event() {}
+
+func (*WatchExchangeRatesResponse_Update) isWatchExchangeRatesResponse_Event() {}
+
+func (*WatchExchangeRatesResponse_Heartbeat) isWatchExchangeRatesResponse_Event() {}
+
+// RatesSnapshot holds the rates of all the watched providers.
+type RatesSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// rates of every watched provider which has rates.
+	Rates []*ProviderRates `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	// version of the snapshot, to resume from.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *RatesSnapshot) Reset() {
+	*x = RatesSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RatesSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatesSnapshot) ProtoMessage() {}
+
+func (x *RatesSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatesSnapshot.ProtoReflect.Descriptor instead.
+func (*RatesSnapshot) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RatesSnapshot) GetRates() []*ProviderRates {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+func (x *RatesSnapshot) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// ProviderRates holds the watched rates of a provider.
+type ProviderRates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// provider of the rates.
+	ExchangeProvider string `protobuf:"bytes,1,opt,name=exchange_provider,json=exchangeProvider,proto3" json:"exchange_provider,omitempty"`
+	// currency code the rates are quoted against, e.g. USD.
+	Base string `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	// List of currencies and their current rate, ordered by code.
+	Currencies []*Currency `protobuf:"bytes,3,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	// timestamp at which the exchange rate was taken from.
+	ExchangeRateDatetime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=exchange_rate_datetime,json=exchangeRateDatetime,proto3" json:"exchange_rate_datetime,omitempty"`
+	// version of the rates, to resume from.
+	Version uint64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *ProviderRates) Reset() {
+	*x = ProviderRates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderRates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderRates) ProtoMessage() {}
+
+func (x *ProviderRates) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderRates.ProtoReflect.Descriptor instead.
+func (*ProviderRates) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ProviderRates) GetExchangeProvider() string {
+	if x != nil {
+		return x.ExchangeProvider
+	}
+	return ""
+}
+
+func (x *ProviderRates) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *ProviderRates) GetCurrencies() []*Currency {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+func (x *ProviderRates) GetExchangeRateDatetime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExchangeRateDatetime
+	}
+	return nil
+}
+
+func (x *ProviderRates) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Heartbeat is sent on an idle watch.
+type Heartbeat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// time of the heartbeat.
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// version of the last rates sent, to resume from.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *Heartbeat) Reset() {
+	*x = Heartbeat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Heartbeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Heartbeat) ProtoMessage() {}
+
+func (x *Heartbeat) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Heartbeat.ProtoReflect.Descriptor instead.
+func (*Heartbeat) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Heartbeat) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *Heartbeat) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// request for the supported exchange providers.
+type ListProvidersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListProvidersRequest) Reset() {
+	*x = ListProvidersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProvidersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProvidersRequest) ProtoMessage() {}
+
+func (x *ListProvidersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProvidersRequest.ProtoReflect.Descriptor instead.
+func (*ListProvidersRequest) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{33}
+}
+
+// response with the supported exchange providers.
+type ListProvidersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// supported exchange providers, ordered by name.
+	Providers []*Provider `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+}
+
+func (x *ListProvidersResponse) Reset() {
+	*x = ListProvidersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProvidersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProvidersResponse) ProtoMessage() {}
+
+func (x *ListProvidersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProvidersResponse.ProtoReflect.Descriptor instead.
+func (*ListProvidersResponse) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListProvidersResponse) GetProviders() []*Provider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+// Provider describes a supported exchange provider.
+type Provider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name of the provider, the value of exchange_provider in the requests, e.g. "fixer".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// true for the provider used when a request does not specify one.
+	Default bool `protobuf:"varint,2,opt,name=default,proto3" json:"default,omitempty"`
+	// what the provider can be used for.
+	Capabilities []ProviderCapability `protobuf:"varint,3,rep,packed,name=capabilities,proto3,enum=api.proto.v1alpha1.currency.converter.ProviderCapability" json:"capabilities,omitempty"`
+	// true when the rates of the provider are refreshed by the service in the background.
+	Refreshed bool `protobuf:"varint,4,opt,name=refreshed,proto3" json:"refreshed,omitempty"`
+	// reachability of the provider, from its last fetches.
+	Health ProviderHealth `protobuf:"varint,5,opt,name=health,proto3,enum=api.proto.v1alpha1.currency.converter.ProviderHealth" json:"health,omitempty"`
+	// time of the first failure of the provider after its last success. Unset when the provider is reachable.
+	FailingSince *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=failing_since,json=failingSince,proto3" json:"failing_since,omitempty"`
+}
+
+func (x *Provider) Reset() {
+	*x = Provider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Provider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Provider) ProtoMessage() {}
+
+func (x *Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Provider.ProtoReflect.Descriptor instead.
+func (*Provider) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Provider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Provider) GetDefault() bool {
+	if x != nil {
+		return x.Default
+	}
+	return false
+}
+
+func (x *Provider) GetCapabilities() []ProviderCapability {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *Provider) GetRefreshed() bool {
+	if x != nil {
+		return x.Refreshed
+	}
+	return false
+}
+
+func (x *Provider) GetHealth() ProviderHealth {
+	if x != nil {
+		return x.Health
+	}
+	return ProviderHealth_PROVIDER_HEALTH_UNSPECIFIED
+}
+
+func (x *Provider) GetFailingSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailingSince
+	}
+	return nil
+}
+
+// request for the currencies supported by an exchange provider.
+type ListCurrenciesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional. provider to list the currencies of, e.g. "fixer". [default: CurrencyLayer]
+	ExchangeProvider string `protobuf:"bytes,1,opt,name=exchange_provider,json=exchangeProvider,proto3" json:"exchange_provider,omitempty"`
+}
+
+func (x *ListCurrenciesRequest) Reset() {
+	*x = ListCurrenciesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCurrenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCurrenciesRequest) ProtoMessage() {}
+
+func (x *ListCurrenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCurrenciesRequest.ProtoReflect.Descriptor instead.
+func (*ListCurrenciesRequest) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListCurrenciesRequest) GetExchangeProvider() string {
+	if x != nil {
+		return x.ExchangeProvider
+	}
+	return ""
+}
+
+// response with the currencies supported by an exchange provider.
+type ListCurrenciesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// provider of the currencies.
+	ExchangeProvider string `protobuf:"bytes,1,opt,name=exchange_provider,json=exchangeProvider,proto3" json:"exchange_provider,omitempty"`
+	// currencies supported by the provider, ordered by code.
+	Currencies []*CurrencyDescription `protobuf:"bytes,2,rep,name=currencies,proto3" json:"currencies,omitempty"`
+}
+
+func (x *ListCurrenciesResponse) Reset() {
+	*x = ListCurrenciesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCurrenciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCurrenciesResponse) ProtoMessage() {}
+
+func (x *ListCurrenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCurrenciesResponse.ProtoReflect.Descriptor instead.
+func (*ListCurrenciesResponse) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListCurrenciesResponse) GetExchangeProvider() string {
+	if x != nil {
+		return x.ExchangeProvider
+	}
+	return ""
+}
+
+func (x *ListCurrenciesResponse) GetCurrencies() []*CurrencyDescription {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+// CurrencyDescription describes a currency of the registry.
+type CurrencyDescription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ISO 4217 alphabetic code, or the ticker of a crypto asset, e.g. "EUR" or "BTC".
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// ISO 4217 numeric code, e.g. "978". Empty for crypto assets.
+	NumericCode string `protobuf:"bytes,2,opt,name=numeric_code,json=numericCode,proto3" json:"numeric_code,omitempty"`
+	// English name of the currency.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// number of decimals of the currency, e.g. 2 for EUR, 0 for JPY and 3 for KWD.
+	MinorUnits int32 `protobuf:"varint,4,opt,name=minor_units,json=minorUnits,proto3" json:"minor_units,omitempty"`
+	// international symbol of the currency, e.g. "€" for EUR.
+	Symbol string `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// true for crypto assets, which are not part of ISO 4217.
+	Crypto bool `protobuf:"varint,6,opt,name=crypto,proto3" json:"crypto,omitempty"`
+	// true when a live rate of the currency is cached for the provider.
+	RateCached bool `protobuf:"varint,7,opt,name=rate_cached,json=rateCached,proto3" json:"rate_cached,omitempty"`
+}
+
+func (x *CurrencyDescription) Reset() {
+	*x = CurrencyDescription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrencyDescription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyDescription) ProtoMessage() {}
+
+func (x *CurrencyDescription) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyDescription.ProtoReflect.Descriptor instead.
+func (*CurrencyDescription) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CurrencyDescription) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CurrencyDescription) GetNumericCode() string {
+	if x != nil {
+		return x.NumericCode
+	}
+	return ""
+}
+
+func (x *CurrencyDescription) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CurrencyDescription) GetMinorUnits() int32 {
+	if x != nil {
+		return x.MinorUnits
+	}
+	return 0
+}
+
+func (x *CurrencyDescription) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *CurrencyDescription) GetCrypto() bool {
+	if x != nil {
+		return x.Crypto
+	}
+	return false
+}
+
+func (x *CurrencyDescription) GetRateCached() bool {
+	if x != nil {
+		return x.RateCached
+	}
+	return false
+}
+
+// Currency is the object representing a currency with the code and value of it.
+type Currency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// code is the standardised currency code for a specific country.
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// value is the non-negative value of that currency.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// value formatted with the grouping, separators and currency symbol of the locale of the request,
+	// e.g. "1.234,56 €" in de-DE. The rates are only formatted with the separators, with all their digits,
+	// e.g. "0,006666666666666667". Empty when the request has no locale.
+	FormattedValue string `protobuf:"bytes,3,opt,name=formatted_value,json=formattedValue,proto3" json:"formatted_value,omitempty"`
+}
+
+func (x *Currency) Reset() {
+	*x = Currency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Currency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Currency) ProtoMessage() {}
+
+func (x *Currency) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Currency.ProtoReflect.Descriptor instead.
+func (*Currency) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Currency) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Currency) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Currency) GetFormattedValue() string {
+	if x != nil {
+		return x.FormattedValue
+	}
+	return ""
+}
+
+// Options to paginate a response using offsets.
+type OffsetPaginationOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional. Offset at which to start returning records.
+	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Optional. Number of records to return.
+	Size uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *OffsetPaginationOptions) Reset() {
+	*x = OffsetPaginationOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OffsetPaginationOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OffsetPaginationOptions) ProtoMessage() {}
+
+func (x *OffsetPaginationOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OffsetPaginationOptions.ProtoReflect.Descriptor instead.
+func (*OffsetPaginationOptions) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *OffsetPaginationOptions) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *OffsetPaginationOptions) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+var File_v1alpha1_currencyconverter_currency_converter_server_proto protoreflect.FileDescriptor
+
+var file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDesc = []byte{
+	0x0a, 0x3a, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2f, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x25, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x72, 0x70, 0x63, 0x2f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x91, 0x02, 0x0a,
+	0x11, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x48, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x13, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x02, 0x74,
+	0x6f, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x58,
+	0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x22, 0xbe, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52,
+	0x02, 0x74, 0x6f, 0x12, 0x17, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2b, 0x0a, 0x11,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x22, 0xbb, 0x05, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x09, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x12, 0x43, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x0d,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x4b, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x50,
+	0x0a, 0x16, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x14, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x2e, 0x0a, 0x13, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x58, 0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x74, 0x6f, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x6f, 0x52, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3f,
+	0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x22,
+	0xe1, 0x02, 0x0a, 0x04, 0x46, 0x65, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x69, 0x64, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x69, 0x64, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x65, 0x64, 0x52, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64,
+	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x54, 0x0a,
+	0x0d, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0c, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x4e, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x09, 0x66, 0x65, 0x65, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x4e, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x79, 0x0a, 0x18, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x5d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x03, 0xe0,
+	0x41, 0x02, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xca,
+	0x01, 0x0a, 0x19, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x39, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x50, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x12,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x65,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
+	0x59, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xe7, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x70, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x42, 0x70, 0x73,
+	0x22, 0x6f, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x13, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02,
+	0x52, 0x02, 0x74, 0x6f, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x22, 0x9d, 0x03, 0x0a, 0x05, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12,
+	0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x69, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x69, 0x64, 0x52, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x50, 0x0a, 0x16, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x14, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x44,
+	0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0xf5, 0x01, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x57, 0x69, 0x74,
+	0x68, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x08, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x48, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x03, 0xe0, 0x41,
+	0x02, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x58, 0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x94, 0x02, 0x0a, 0x14, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x54, 0x6f, 0x4d, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x48, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x13, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x02, 0x74,
+	0x6f, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x58,
+	0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x22, 0x6f, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x54, 0x6f, 0x4d, 0x61, 0x6e,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x22, 0x9a, 0x02, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x42, 0x61, 0x73,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x07, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x03, 0xe0, 0x41,
+	0x02, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x13, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x02, 0x74, 0x6f, 0x12,
+	0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x58, 0x0a, 0x0d,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0xa6,
+	0x03, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x04, 0x6c, 0x65, 0x67, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x12, 0x45, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x58,
+	0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x50, 0x0a, 0x16, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x14, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x13, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x44,
+	0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xf2, 0x01, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x5d, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x58, 0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x83, 0x01, 0x0a,
+	0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69,
+	0x65, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x59, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x39, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x5d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42,
+	0x03, 0xe0, 0x41, 0x02, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0xae, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x59, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0xbd, 0x02, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x63,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x64,
+	0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x9b, 0x02, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4f, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x50, 0x0a, 0x16, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x14, 0x65,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0xa0, 0x01, 0x0a, 0x16, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x62, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x22, 0xba, 0x02, 0x0a, 0x17, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61,
+	0x6c, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0a, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x16, 0x65, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x14, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x54, 0x0a, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65,
+	0x73, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22,
+	0xb3, 0x03, 0x0a, 0x15, 0x52, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x13, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03,
+	0xe0, 0x41, 0x02, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x3e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x5e, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x47, 0x72, 0x61, 0x6e, 0x75,
+	0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x63, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xdb, 0x02, 0x0a, 0x16, 0x52, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x74, 0x6f, 0x12, 0x5e, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x47, 0x72, 0x61, 0x6e,
+	0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x48, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x74,
+	0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x4b,
+	0x0a, 0x07, 0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x52, 0x07, 0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x4f, 0x0a, 0x09, 0x52, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x72, 0x61, 0x74, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x0a, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x69, 0x67, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x6c,
+	0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x77, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0xa4, 0x01,
+	0x0a, 0x19, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x65,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61,
+	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63,
+	0x6f, 0x64, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x11, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x9b, 0x02, 0x0a, 0x1a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x61,
+	0x74, 0x65, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x08, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x4e, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x61, 0x74, 0x65, 0x73, 0x48, 0x00, 0x52,
+	0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x50, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74,
+	0x62, 0x65, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x48, 0x00, 0x52, 0x09,
+	0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0x75, 0x0a, 0x0d, 0x52, 0x61, 0x74, 0x65, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x4a, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x34, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8d, 0x02, 0x0a, 0x0d, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x65,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0a,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x50, 0x0a,
+	0x16, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x14, 0x65, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x55, 0x0a, 0x09, 0x48, 0x65, 0x61,
+	0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x66, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4d, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x22, 0xc5, 0x02, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x5d, 0x0a, 0x0c, 0x63,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x39, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x63, 0x61,
+	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x65, 0x64, 0x12, 0x4d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x3f, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c,
+	0x69, 0x6e, 0x67, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x22, 0x44, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0xa1,
+	0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x5a, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69,
+	0x65, 0x73, 0x22, 0xd2, 0x01, 0x0a, 0x13, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x5f, 0x75,
+	0x6e, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x6f,
+	0x72, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x61, 0x74,
+	0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x22, 0x5d, 0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65,
+	0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x45, 0x0a, 0x17, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x2a, 0x99, 0x01,
+	0x0a, 0x0c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1d,
+	0x0a, 0x19, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a,
+	0x17, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x48,
+	0x41, 0x4c, 0x46, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x4f,
+	0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x48, 0x41, 0x4c, 0x46,
+	0x5f, 0x55, 0x50, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x4c, 0x4f, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x19,
+	0x0a, 0x15, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x43, 0x45, 0x49, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x2a, 0x8b, 0x01, 0x0a, 0x15, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x73, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x23, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x49, 0x43, 0x41,
+	0x4c, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x53, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f,
+	0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x53,
+	0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x10,
+	0x01, 0x12, 0x24, 0x0a, 0x20, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x49, 0x43, 0x41, 0x4c, 0x5f,
+	0x52, 0x41, 0x54, 0x45, 0x53, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x50, 0x52, 0x4f,
+	0x56, 0x49, 0x44, 0x45, 0x52, 0x10, 0x02, 0x2a, 0x83, 0x01, 0x0a, 0x15, 0x54, 0x69, 0x6d, 0x65,
+	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x47, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x45, 0x53,
+	0x5f, 0x47, 0x52, 0x41, 0x4e, 0x55, 0x4c, 0x41, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x49,
+	0x4d, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x45, 0x53, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x55, 0x4c,
+	0x41, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x54,
+	0x49, 0x4d, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x45, 0x53, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x55,
+	0x4c, 0x41, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x02, 0x2a, 0xac, 0x01,
+	0x0a, 0x12, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x1f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52,
+	0x5f, 0x43, 0x41, 0x50, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x52, 0x4f,
+	0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x43, 0x41, 0x50, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59,
+	0x5f, 0x4c, 0x49, 0x56, 0x45, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x53, 0x10, 0x01, 0x12, 0x23, 0x0a,
+	0x1f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x43, 0x41, 0x50, 0x41, 0x42, 0x49,
+	0x4c, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x49, 0x56, 0x4f, 0x54, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x53,
+	0x10, 0x02, 0x12, 0x28, 0x0a, 0x24, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x43,
+	0x41, 0x50, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52,
+	0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x53, 0x10, 0x03, 0x2a, 0x8d, 0x01, 0x0a,
+	0x0e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12,
+	0x1f, 0x0a, 0x1b, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x48, 0x45, 0x41, 0x4c,
+	0x54, 0x48, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x1b, 0x0a, 0x17, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x48, 0x45, 0x41,
+	0x4c, 0x54, 0x48, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x01, 0x12, 0x1c, 0x0a,
+	0x18, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48,
+	0x5f, 0x44, 0x45, 0x47, 0x52, 0x41, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x50,
+	0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x55,
+	0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x32, 0xe7, 0x15, 0x0a,
+	0x18, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xa2, 0x01, 0x0a, 0x07, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x12, 0x38, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x39, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x12, 0xb8,
+	0x01, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x12, 0x3f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x39, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x2f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0xc2, 0x01, 0x0a, 0x11, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x3f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x40, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2f, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x12, 0x9c,
+	0x01, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x39,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22,
+	0x19, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xc6, 0x01,
+	0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x57, 0x69, 0x74, 0x68, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x12, 0x3e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x57, 0x69, 0x74, 0x68, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x39, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x31, 0x22, 0x2c, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x73,
+	0x2f, 0x7b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0xb3, 0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x54, 0x6f, 0x4d, 0x61, 0x6e, 0x79, 0x12, 0x3b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x54, 0x6f, 0x4d, 0x61, 0x6e, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x54, 0x6f, 0x4d, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2f,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x2f, 0x6d, 0x61, 0x6e, 0x79, 0x12, 0xb8, 0x01, 0x0a,
+	0x0d, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x12, 0x3b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x42, 0x61,
+	0x73, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x42, 0x61, 0x73, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x26, 0x22, 0x21, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x2f, 0x62, 0x61,
+	0x73, 0x6b, 0x65, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0xba, 0x01, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x12, 0x3d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22,
+	0x20, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x2f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x3a, 0x01, 0x2a, 0x12, 0x8e, 0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x3b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0xb8, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x3f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2f, 0x72, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0xc7, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63,
+	0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x3d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x2f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69,
+	0x63, 0x61, 0x6c, 0x2f, 0x7b, 0x64, 0x61, 0x74, 0x65, 0x7d, 0x12, 0xbd, 0x01, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x52, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x3c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0xa7, 0x01, 0x0a, 0x0d, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x3b, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12,
+	0x13, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x12, 0xc9, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x3c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x2f, 0x7b, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x7d, 0x2f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x12, 0xc3, 0x01, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x40, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x41, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x77,
+	0x61, 0x74, 0x63, 0x68, 0x30, 0x01, 0x42, 0x26, 0x5a, 0x24, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescOnce sync.Once
+	file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescData = file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDesc
+)
+
+func file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescGZIP() []byte {
+	file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescOnce.Do(func() {
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescData = protoimpl.X.CompressGZIP(file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescData)
+	})
+	return file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDescData
+}
+
+var file_v1alpha1_currencyconverter_currency_converter_server_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
+var file_v1alpha1_currencyconverter_currency_converter_server_proto_goTypes = []interface{}{
+	(RoundingMode)(0),                  // 0: api.proto.v1alpha1.currency.converter.RoundingMode
+	(HistoricalRatesSource)(0),         // 1: api.proto.v1alpha1.currency.converter.HistoricalRatesSource
+	(TimeSeriesGranularity)(0),         // 2: api.proto.v1alpha1.currency.converter.TimeSeriesGranularity
+	(ProviderCapability)(0),            // 3: api.proto.v1alpha1.currency.converter.ProviderCapability
+	(ProviderHealth)(0),                // 4: api.proto.v1alpha1.currency.converter.ProviderHealth
+	(*ConversionRequest)(nil),          // 5: api.proto.v1alpha1.currency.converter.ConversionRequest
+	(*ReverseConversionRequest)(nil),   // 6: api.proto.v1alpha1.currency.converter.ReverseConversionRequest
+	(*ConversionResponse)(nil),         // 7: api.proto.v1alpha1.currency.converter.ConversionResponse
+	(*Fees)(nil),                       // 8: api.proto.v1alpha1.currency.converter.Fees
+	(*CompareConversionRequest)(nil),   // 9: api.proto.v1alpha1.currency.converter.CompareConversionRequest
+	(*CompareConversionResponse)(nil),  // 10: api.proto.v1alpha1.currency.converter.CompareConversionResponse
+	(*ProviderConversion)(nil),         // 11: api.proto.v1alpha1.currency.converter.ProviderConversion
+	(*ConversionRateStats)(nil),        // 12: api.proto.v1alpha1.currency.converter.ConversionRateStats
+	(*CreateQuoteRequest)(nil),         // 13: api.proto.v1alpha1.currency.converter.CreateQuoteRequest
+	(*Quote)(nil),                      // 14: api.proto.v1alpha1.currency.converter.Quote
+	(*ConvertWithQuoteRequest)(nil),    // 15: api.proto.v1alpha1.currency.converter.ConvertWithQuoteRequest
+	(*ConvertToManyRequest)(nil),       // 16: api.proto.v1alpha1.currency.converter.ConvertToManyRequest
+	(*ConvertToManyResponse)(nil),      // 17: api.proto.v1alpha1.currency.converter.ConvertToManyResponse
+	(*ConvertBasketRequest)(nil),       // 18: api.proto.v1alpha1.currency.converter.ConvertBasketRequest
+	(*ConvertBasketResponse)(nil),      // 19: api.proto.v1alpha1.currency.converter.ConvertBasketResponse
+	(*BatchConversionRequest)(nil),     // 20: api.proto.v1alpha1.currency.converter.BatchConversionRequest
+	(*BatchConversionResponse)(nil),    // 21: api.proto.v1alpha1.currency.converter.BatchConversionResponse
+	(*BatchConversionResult)(nil),      // 22: api.proto.v1alpha1.currency.converter.BatchConversionResult
+	(*ConvertStreamRequest)(nil),       // 23: api.proto.v1alpha1.currency.converter.ConvertStreamRequest
+	(*ConvertStreamResponse)(nil),      // 24: api.proto.v1alpha1.currency.converter.ConvertStreamResponse
+	(*ListExchangeRatesRequest)(nil),   // 25: api.proto.v1alpha1.currency.converter.ListExchangeRatesRequest
+	(*ListExchangeRatesResponse)(nil),  // 26: api.proto.v1alpha1.currency.converter.ListExchangeRatesResponse
+	(*HistoricalRatesRequest)(nil),     // 27: api.proto.v1alpha1.currency.converter.HistoricalRatesRequest
+	(*HistoricalRatesResponse)(nil),    // 28: api.proto.v1alpha1.currency.converter.HistoricalRatesResponse
+	(*RateTimeSeriesRequest)(nil),      // 29: api.proto.v1alpha1.currency.converter.RateTimeSeriesRequest
+	(*RateTimeSeriesResponse)(nil),     // 30: api.proto.v1alpha1.currency.converter.RateTimeSeriesResponse
+	(*RatePoint)(nil),                  // 31: api.proto.v1alpha1.currency.converter.RatePoint
+	(*RateCandle)(nil),                 // 32: api.proto.v1alpha1.currency.converter.RateCandle
+	(*WatchExchangeRatesRequest)(nil),  // 33: api.proto.v1alpha1.currency.converter.WatchExchangeRatesRequest
+	(*WatchExchangeRatesResponse)(nil), // 34: api.proto.v1alpha1.currency.converter.WatchExchangeRatesResponse
+	(*RatesSnapshot)(nil),              // 35: api.proto.v1alpha1.currency.converter.RatesSnapshot
+	(*ProviderRates)(nil),              // 36: api.proto.v1alpha1.currency.converter.ProviderRates
+	(*Heartbeat)(nil),                  // 37: api.proto.v1alpha1.currency.converter.Heartbeat
+	(*ListProvidersRequest)(nil),       // 38: api.proto.v1alpha1.currency.converter.ListProvidersRequest
+	(*ListProvidersResponse)(nil),      // 39: api.proto.v1alpha1.currency.converter.ListProvidersResponse
+	(*Provider)(nil),                   // 40: api.proto.v1alpha1.currency.converter.Provider
+	(*ListCurrenciesRequest)(nil),      // 41: api.proto.v1alpha1.currency.converter.ListCurrenciesRequest
+	(*ListCurrenciesResponse)(nil),     // 42: api.proto.v1alpha1.currency.converter.ListCurrenciesResponse
+	(*CurrencyDescription)(nil),        // 43: api.proto.v1alpha1.currency.converter.CurrencyDescription
+	(*Currency)(nil),                   // 44: api.proto.v1alpha1.currency.converter.Currency
+	(*OffsetPaginationOptions)(nil),    // 45: api.proto.v1alpha1.currency.converter.OffsetPaginationOptions
+	(*timestamppb.Timestamp)(nil),      // 46: google.protobuf.Timestamp
+	(*status.Status)(nil),              // 47: google.rpc.Status
+}
+var file_v1alpha1_currencyconverter_currency_converter_server_proto_depIdxs = []int32{
+	44, // 0: api.proto.v1alpha1.currency.converter.ConversionRequest.from:type_name -> api.proto.v1alpha1.currency.converter.Currency
+	0,  // 1: api.proto.v1alpha1.currency.converter.ConversionRequest.rounding_mode:type_name -> api.proto.v1alpha1.currency.converter.RoundingMode
+	44, // 2: api.proto.v1alpha1.currency.converter.ReverseConversionRequest.to:type_name -> api.proto.v1alpha1.currency.converter.Currency
+	44, // 3: api.proto.v1alpha1.currency.converter.ConversionResponse.converted:type_name -> api.proto.v1alpha1.currency.converter.Currency
+	44, // 4: api.proto.v1alpha1.currency.converter.ConversionResponse.from:type_name -> api.proto.v1alpha1.currency.converter.Currency
+	46, // 5: api.proto.v1alpha1.currency.converter.ConversionResponse.conversion_datetime:type_name -> google.protobuf.Timestamp
+	46, // 6: api.proto.v1alpha1.currency.converter.ConversionResponse.exchange_rate_datetime:type_name -> google.protobuf.Timestamp
+	0,  // 7: api.proto.v1alpha1.currency.converter.ConversionResponse.rounding_mode:type_name -> api.proto.v1alpha1.currency.converter.RoundingMode
+	8,  // 8: api.proto.v1alpha1.currency.converter.ConversionResponse.fees:type_name -> api.proto.v1alpha1.currency.converter.Fees
+	44, // 9: api.proto.v1alpha1.currency.converter.Fees.spread_amount:type_name -> api.proto.v1alpha1.currency.converter.Currency
+	44, // 10: api.proto.v1alpha1.currency.converter.Fees.fee_amount:type_name -> api.proto.v1alpha1.currency.converter.Currency
+	44, // 11: api.proto.v1alpha1.currency.converter.Fees.net_amount:type_name -> api.proto.v1alpha1.currency.converter.Currency
+	5,  // 12: api.proto.v1alpha1.currency.converter.CompareConversionRequest.conversion:type_name -> api.proto.v1alpha1.currency.converter.ConversionRequest
+	11, // 13: api.proto.v1alpha1.currency.converter.CompareConversionResponse.conversions:type_name -> api.proto.v1alpha1.currency.converter.ProviderConversion
+	12, // 14: api.proto.v1alpha1.currency.converter.CompareConversionResponse.stats:type_name -> api.proto.v1alpha1.currency.converter.ConversionRateStats
+	7,  // 15: api.proto.v1alpha1.currency.converter.ProviderConversion.conversion:type_name -> api.proto.v1alpha1.currency.converter.ConversionResponse
+	47, // 16: api.proto.v1alpha1.currency.converter.ProviderConversion.status:type_name -> google.rpc.Status
+	46, // 17: api.proto.v1alpha1.currency.converter.Quote.exchange_rate_datetime:type_name -> google.protobuf.Timestamp
+	46, // 18: api.proto.v1alpha1.currency.converter.Quote.create_time:type_name -> google.protobuf.Timestamp
+	46, // 19: api.proto.v1alpha1.currency.converter.Quote.expire_time:type_name -> google.protobuf.Timestamp
+	44, // 20: api.proto.v1alpha1.currency.converter.ConvertWithQuoteRequest.from:type_name -> api.proto.v1alpha1.currency.converter.Currency
+	0,  // 21: api.proto.v1alpha1.currency.converter.ConvertWithQuoteRequest.rounding_mode:type_name -> api.proto.v1alpha1.currency.converter.RoundingMode
+	44, // 22: api.proto.v1alpha1.currency.converter.ConvertToManyRequest.from:type_name -> api.proto.v1alpha1.currency.converter.Currency
+	0,  // 23: api.proto.v1alpha1.currency.converter.ConvertToManyRequest.rounding_mode:type_name -> api.proto.v1alpha1.currency.converter.RoundingMode
+	22, // 24: api.proto.v1alpha1.currency.converter.ConvertToManyResponse.results:type_name -> api.proto.v1alpha1.currency.converter.BatchConversionResult
+	44, // 25: api.proto.v1alpha1.currency.converter.ConvertBasketRequest.amounts:type_name -> api.proto.v1alpha1.currency.converter.Currency
+	0,  // 26: api.proto.v1alpha1.currency.converter.ConvertBasketRequest.rounding_mode:type_name -> api.proto.v1alpha1.currency.converter.RoundingMode
+	7,  // 27: api.proto.v1alpha1.currency.converter.ConvertBasketResponse.legs:type_name -> api.proto.v1alpha1.currency.converter.ConversionResponse
+	44, // 28: api.proto.v1alpha1.currency.converter.ConvertBasketResponse.total:type_name -> api.proto.v1alpha1.currency.converter.Currency
+	0,  // 29: api.proto.v1alpha1.currency.converter.ConvertBasketResponse.rounding_mode:type_name -> api.proto.v1alpha1.currency.converter.RoundingMode
+	46, // 30: api.proto.v1alpha1.currency.converter.ConvertBasketResponse.exchange_rate_datetime:type_name -> google.protobuf.Timestamp
+	46, // 31: api.proto.v1alpha1.currency.converter.ConvertBasketResponse.conversion_datetime:type_name -> google.protobuf.Timestamp
+	5,  // 32: api.proto.v1alpha1.currency.converter.BatchConversionRequest.currencies:type_name -> api.proto.v1alpha1.currency.converter.ConversionRequest
+	0,  // 33: api.proto.v1alpha1.currency.converter.BatchConversionRequest.rounding_mode:type_name -> api.proto.v1alpha1.currency.converter.RoundingMode
+	22, // 34: api.proto.v1alpha1.currency.converter.BatchConversionResponse.results:type_name -> api.proto.v1alpha1.currency.converter.BatchConversionResult
+	7,  // 35: api.proto.v1alpha1.currency.converter.BatchConversionResult.conversion:type_name -> api.proto.v1alpha1.currency.converter.ConversionResponse
+	47, // 36: api.proto.v1alpha1.currency.converter.BatchConversionResult.status:type_name -> google.rpc.Status
+	5,  // 37: api.proto.v1alpha1.currency.converter.ConvertStreamRequest.conversion:type_name -> api.proto.v1alpha1.currency.converter.ConversionRequest
+	7,  // 38: api.proto.v1alpha1.currency.converter.ConvertStreamResponse.conversion:type_name -> api.proto.v1alpha1.currency.converter.ConversionResponse
+	47, // 39: api.proto.v1alpha1.currency.converter.ConvertStreamResponse.status:type_name -> google.rpc.Status
+	45, // 40: api.proto.v1alpha1.currency.converter.ListExchangeRatesRequest.pagination:type_name -> api.proto.v1alpha1.currency.converter.OffsetPaginationOptions
+	44, // 41: api.proto.v1alpha1.currency.converter.ListExchangeRatesResponse.currencies:type_name -> api.proto.v1alpha1.currency.converter.Currency
+	46, // 42: api.proto.v1alpha1.currency.converter.ListExchangeRatesResponse.exchange_rate_datetime:type_name -> google.protobuf.Timestamp
+	44, // 43: api.proto.v1alpha1.currency.converter.HistoricalRatesResponse.currencies:type_name -> api.proto.v1alpha1.currency.converter.Currency
+	46, // 44: api.proto.v1alpha1.currency.converter.HistoricalRatesResponse.exchange_rate_datetime:type_name -> google.protobuf.Timestamp
+	1,  // 45: api.proto.v1alpha1.currency.converter.HistoricalRatesResponse.source:type_name -> api.proto.v1alpha1.currency.converter.HistoricalRatesSource
+	46, // 46: api.proto.v1alpha1.currency.converter.RateTimeSeriesRequest.start_time:type_name -> google.protobuf.Timestamp
+	46, // 47: api.proto.v1alpha1.currency.converter.RateTimeSeriesRequest.end_time:type_name -> google.protobuf.Timestamp
+	2,  // 48: api.proto.v1alpha1.currency.converter.RateTimeSeriesRequest.granularity:type_name -> api.proto.v1alpha1.currency.converter.TimeSeriesGranularity
+	2,  // 49: api.proto.v1alpha1.currency.converter.RateTimeSeriesResponse.granularity:type_name -> api.proto.v1alpha1.currency.converter.TimeSeriesGranularity
+	31, // 50: api.proto.v1alpha1.currency.converter.RateTimeSeriesResponse.points:type_name -> api.proto.v1alpha1.currency.converter.RatePoint
+	32, // 51: api.proto.v1alpha1.currency.converter.RateTimeSeriesResponse.candles:type_name -> api.proto.v1alpha1.currency.converter.RateCandle
+	46, // 52: api.proto.v1alpha1.currency.converter.RatePoint.time:type_name -> google.protobuf.Timestamp
+	46, // 53: api.proto.v1alpha1.currency.converter.RateCandle.time:type_name -> google.protobuf.Timestamp
+	35, // 54: api.proto.v1alpha1.currency.converter.WatchExchangeRatesResponse.snapshot:type_name -> api.proto.v1alpha1.currency.converter.RatesSnapshot
+	36, // 55: api.proto.v1alpha1.currency.converter.WatchExchangeRatesResponse.update:type_name -> api.proto.v1alpha1.currency.converter.ProviderRates
+	37, // 56: api.proto.v1alpha1.currency.converter.WatchExchangeRatesResponse.heartbeat:type_name -> api.proto.v1alpha1.currency.converter.Heartbeat
+	36, // 57: api.proto.v1alpha1.currency.converter.RatesSnapshot.rates:type_name -> api.proto.v1alpha1.currency.converter.ProviderRates
+	44, // 58: api.proto.v1alpha1.currency.converter.ProviderRates.currencies:type_name -> api.proto.v1alpha1.currency.converter.Currency
+	46, // 59: api.proto.v1alpha1.currency.converter.ProviderRates.exchange_rate_datetime:type_name -> google.protobuf.Timestamp
+	46, // 60: api.proto.v1alpha1.currency.converter.Heartbeat.time:type_name -> google.protobuf.Timestamp
+	40, // 61: api.proto.v1alpha1.currency.converter.ListProvidersResponse.providers:type_name -> api.proto.v1alpha1.currency.converter.Provider
+	3,  // 62: api.proto.v1alpha1.currency.converter.Provider.capabilities:type_name -> api.proto.v1alpha1.currency.converter.ProviderCapability
+	4,  // 63: api.proto.v1alpha1.currency.converter.Provider.health:type_name -> api.proto.v1alpha1.currency.converter.ProviderHealth
+	46, // 64: api.proto.v1alpha1.currency.converter.Provider.failing_since:type_name -> google.protobuf.Timestamp
+	43, // 65: api.proto.v1alpha1.currency.converter.ListCurrenciesResponse.currencies:type_name -> api.proto.v1alpha1.currency.converter.CurrencyDescription
+	5,  // 66: api.proto.v1alpha1.currency.converter.CurrencyConverterService.Convert:input_type -> api.proto.v1alpha1.currency.converter.ConversionRequest
+	6,  // 67: api.proto.v1alpha1.currency.converter.CurrencyConverterService.ReverseConvert:input_type -> api.proto.v1alpha1.currency.converter.ReverseConversionRequest
+	9,  // 68: api.proto.v1alpha1.currency.converter.CurrencyConverterService.CompareConversion:input_type -> api.proto.v1alpha1.currency.converter.CompareConversionRequest
+	13, // 69: api.proto.v1alpha1.currency.converter.CurrencyConverterService.CreateQuote:input_type -> api.proto.v1alpha1.currency.converter.CreateQuoteRequest
+	15, // 70: api.proto.v1alpha1.currency.converter.CurrencyConverterService.ConvertWithQuote:input_type -> api.proto.v1alpha1.currency.converter.ConvertWithQuoteRequest
+	16, // 71: api.proto.v1alpha1.currency.converter.CurrencyConverterService.ConvertToMany:input_type -> api.proto.v1alpha1.currency.converter.ConvertToManyRequest
+	18, // 72: api.proto.v1alpha1.currency.converter.CurrencyConverterService.ConvertBasket:input_type -> api.proto.v1alpha1.currency.converter.ConvertBasketRequest
+	20, // 73: api.proto.v1alpha1.currency.converter.CurrencyConverterService.BatchConvert:input_type -> api.proto.v1alpha1.currency.converter.BatchConversionRequest
+	23, // 74: api.proto.v1alpha1.currency.converter.CurrencyConverterService.ConvertStream:input_type -> api.proto.v1alpha1.currency.converter.ConvertStreamRequest
+	25, // 75: api.proto.v1alpha1.currency.converter.CurrencyConverterService.ListExchangeRates:input_type -> api.proto.v1alpha1.currency.converter.ListExchangeRatesRequest
+	27, // 76: api.proto.v1alpha1.currency.converter.CurrencyConverterService.GetHistoricalRates:input_type -> api.proto.v1alpha1.currency.converter.HistoricalRatesRequest
+	29, // 77: api.proto.v1alpha1.currency.converter.CurrencyConverterService.GetRateTimeSeries:input_type -> api.proto.v1alpha1.currency.converter.RateTimeSeriesRequest
+	38, // 78: api.proto.v1alpha1.currency.converter.CurrencyConverterService.ListProviders:input_type -> api.proto.v1alpha1.currency.converter.ListProvidersRequest
+	41, // 79: api.proto.v1alpha1.currency.converter.CurrencyConverterService.ListCurrencies:input_type -> api.proto.v1alpha1.currency.converter.ListCurrenciesRequest
+	33, // 80: api.proto.v1alpha1.currency.converter.CurrencyConverterService.WatchExchangeRates:input_type -> api.proto.v1alpha1.currency.converter.WatchExchangeRatesRequest
+	7,  // 81: api.proto.v1alpha1.currency.converter.CurrencyConverterService.Convert:output_type -> api.proto.v1alpha1.currency.converter.ConversionResponse
+	7,  // 82: api.proto.v1alpha1.currency.converter.CurrencyConverterService.ReverseConvert:output_type -> api.proto.v1alpha1.currency.converter.ConversionResponse
+	10, // 83: api.proto.v1alpha1.currency.converter.CurrencyConverterService.CompareConversion:output_type -> api.proto.v1alpha1.currency.converter.CompareConversionResponse
+	14, // 84: api.proto.v1alpha1.currency.converter.CurrencyConverterService.CreateQuote:output_type -> api.proto.v1alpha1.currency.converter.Quote
+	7,  // 85: api.proto.v1alpha1.currency.converter.CurrencyConverterService.ConvertWithQuote:output_type -> api.proto.v1alpha1.currency.converter.ConversionResponse
+	17, // 86: api.proto.v1alpha1.currency.converter.CurrencyConverterService.ConvertToMany:output_type -> api.proto.v1alpha1.currency.converter.ConvertToManyResponse
+	19, // 87: api.proto.v1alpha1.currency.converter.CurrencyConverterService.ConvertBasket:output_type -> api.proto.v1alpha1.currency.converter.ConvertBasketResponse
+	21, // 88: api.proto.v1alpha1.currency.converter.CurrencyConverterService.BatchConvert:output_type -> api.proto.v1alpha1.currency.converter.BatchConversionResponse
+	24, // 89: api.proto.v1alpha1.currency.converter.CurrencyConverterService.ConvertStream:output_type -> api.proto.v1alpha1.currency.converter.ConvertStreamResponse
+	26, // 90: api.proto.v1alpha1.currency.converter.CurrencyConverterService.ListExchangeRates:output_type -> api.proto.v1alpha1.currency.converter.ListExchangeRatesResponse
+	28, // 91: api.proto.v1alpha1.currency.converter.CurrencyConverterService.GetHistoricalRates:output_type -> api.proto.v1alpha1.currency.converter.HistoricalRatesResponse
+	30, // 92: api.proto.v1alpha1.currency.converter.CurrencyConverterService.GetRateTimeSeries:output_type -> api.proto.v1alpha1.currency.converter.RateTimeSeriesResponse
+	39, // 93: api.proto.v1alpha1.currency.converter.CurrencyConverterService.ListProviders:output_type -> api.proto.v1alpha1.currency.converter.ListProvidersResponse
+	42, // 94: api.proto.v1alpha1.currency.converter.CurrencyConverterService.ListCurrencies:output_type -> api.proto.v1alpha1.currency.converter.ListCurrenciesResponse
+	34, // 95: api.proto.v1alpha1.currency.converter.CurrencyConverterService.WatchExchangeRates:output_type -> api.proto.v1alpha1.currency.converter.WatchExchangeRatesResponse
+	81, // [81:96] is the sub-list for method output_type
+	66, // [66:81] is the sub-list for method input_type
+	66, // [66:66] is the sub-list for extension type_name
+	66, // [66:66] is the sub-list for extension extendee
+	0,  // [0:66] is the sub-list for field type_name
+}
+
+func init() { file_v1alpha1_currencyconverter_currency_converter_server_proto_init() }
+func file_v1alpha1_currencyconverter_currency_converter_server_proto_init() {
+	if File_v1alpha1_currencyconverter_currency_converter_server_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConversionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReverseConversionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConversionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Fees); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareConversionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareConversionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderConversion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConversionRateStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateQuoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Quote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConvertWithQuoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConvertToManyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConvertToManyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
 				return &v.unknownFields
 			default:
 				return nil
 			}
 		}
-		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConvertBasketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConvertBasketResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchConversionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchConversionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchConversionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConvertStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConvertStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListExchangeRatesRequest); i {
 			case 0:
 				return &v.state
@@ -787,7 +4734,7 @@ func file_v1alpha1_currencyconverter_currency_converter_server_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListExchangeRatesResponse); i {
 			case 0:
 				return &v.state
@@ -799,7 +4746,211 @@ func file_v1alpha1_currencyconverter_currency_converter_server_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoricalRatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoricalRatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateTimeSeriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateTimeSeriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RatePoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateCandle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchExchangeRatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchExchangeRatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RatesSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderRates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Heartbeat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProvidersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProvidersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Provider); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCurrenciesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCurrenciesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrencyDescription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Currency); i {
 			case 0:
 				return &v.state
@@ -811,7 +4962,7 @@ func file_v1alpha1_currencyconverter_currency_converter_server_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OffsetPaginationOptions); i {
 			case 0:
 				return &v.state
@@ -824,18 +4975,24 @@ func file_v1alpha1_currencyconverter_currency_converter_server_proto_init() {
 			}
 		}
 	}
+	file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes[29].OneofWrappers = []interface{}{
+		(*WatchExchangeRatesResponse_Snapshot)(nil),
+		(*WatchExchangeRatesResponse_Update)(nil),
+		(*WatchExchangeRatesResponse_Heartbeat)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1alpha1_currencyconverter_currency_converter_server_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      5,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_v1alpha1_currencyconverter_currency_converter_server_proto_goTypes,
 		DependencyIndexes: file_v1alpha1_currencyconverter_currency_converter_server_proto_depIdxs,
+		EnumInfos:         file_v1alpha1_currencyconverter_currency_converter_server_proto_enumTypes,
 		MessageInfos:      file_v1alpha1_currencyconverter_currency_converter_server_proto_msgTypes,
 	}.Build()
 	File_v1alpha1_currencyconverter_currency_converter_server_proto = out.File
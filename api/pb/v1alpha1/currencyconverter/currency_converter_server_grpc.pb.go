@@ -25,10 +25,34 @@ const _ = grpc.SupportPackageIsVersion7
 type CurrencyConverterServiceClient interface {
 	// Get currency conversion.
 	Convert(ctx context.Context, in *ConversionRequest, opts ...grpc.CallOption) (*ConversionResponse, error)
+	// Get the amount of a currency to be converted to receive an exact amount of another currency.
+	ReverseConvert(ctx context.Context, in *ReverseConversionRequest, opts ...grpc.CallOption) (*ConversionResponse, error)
+	// Get a currency conversion with the rates of every supported exchange provider, to compare them.
+	CompareConversion(ctx context.Context, in *CompareConversionRequest, opts ...grpc.CallOption) (*CompareConversionResponse, error)
+	// Lock the exchange rate of a currency pair for a while, to convert with it once.
+	CreateQuote(ctx context.Context, in *CreateQuoteRequest, opts ...grpc.CallOption) (*Quote, error)
+	// Get currency conversion with the rate locked by a quote, which can only be used once before it expires.
+	ConvertWithQuote(ctx context.Context, in *ConvertWithQuoteRequest, opts ...grpc.CallOption) (*ConversionResponse, error)
+	// Get the conversions of one amount to several currencies.
+	ConvertToMany(ctx context.Context, in *ConvertToManyRequest, opts ...grpc.CallOption) (*ConvertToManyResponse, error)
+	// Get the total of amounts in several currencies, converted to one currency.
+	ConvertBasket(ctx context.Context, in *ConvertBasketRequest, opts ...grpc.CallOption) (*ConvertBasketResponse, error)
 	// Get currency conversions in batch.
 	BatchConvert(ctx context.Context, in *BatchConversionRequest, opts ...grpc.CallOption) (*BatchConversionResponse, error)
+	// Convert a stream of currencies, every conversion sent back with the id of its request, as soon as it is converted.
+	ConvertStream(ctx context.Context, opts ...grpc.CallOption) (CurrencyConverterService_ConvertStreamClient, error)
 	// List currency exchange rates.
 	ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesResponse, error)
+	// Get the currency exchange rates of a past date.
+	GetHistoricalRates(ctx context.Context, in *HistoricalRatesRequest, opts ...grpc.CallOption) (*HistoricalRatesResponse, error)
+	// Get the exchange rates of a currency pair over a time range, aggregated by day or by hour.
+	GetRateTimeSeries(ctx context.Context, in *RateTimeSeriesRequest, opts ...grpc.CallOption) (*RateTimeSeriesResponse, error)
+	// List the supported exchange providers, with their capabilities and health.
+	ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error)
+	// List the currencies supported by an exchange provider.
+	ListCurrencies(ctx context.Context, in *ListCurrenciesRequest, opts ...grpc.CallOption) (*ListCurrenciesResponse, error)
+	// Watch the exchange rates, pushed every time they change.
+	WatchExchangeRates(ctx context.Context, in *WatchExchangeRatesRequest, opts ...grpc.CallOption) (CurrencyConverterService_WatchExchangeRatesClient, error)
 }
 
 type currencyConverterServiceClient struct {
@@ -48,6 +72,60 @@ func (c *currencyConverterServiceClient) Convert(ctx context.Context, in *Conver
 	return out, nil
 }
 
+func (c *currencyConverterServiceClient) ReverseConvert(ctx context.Context, in *ReverseConversionRequest, opts ...grpc.CallOption) (*ConversionResponse, error) {
+	out := new(ConversionResponse)
+	err := c.cc.Invoke(ctx, "/api.proto.v1alpha1.currency.converter.CurrencyConverterService/ReverseConvert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyConverterServiceClient) CompareConversion(ctx context.Context, in *CompareConversionRequest, opts ...grpc.CallOption) (*CompareConversionResponse, error) {
+	out := new(CompareConversionResponse)
+	err := c.cc.Invoke(ctx, "/api.proto.v1alpha1.currency.converter.CurrencyConverterService/CompareConversion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyConverterServiceClient) CreateQuote(ctx context.Context, in *CreateQuoteRequest, opts ...grpc.CallOption) (*Quote, error) {
+	out := new(Quote)
+	err := c.cc.Invoke(ctx, "/api.proto.v1alpha1.currency.converter.CurrencyConverterService/CreateQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyConverterServiceClient) ConvertWithQuote(ctx context.Context, in *ConvertWithQuoteRequest, opts ...grpc.CallOption) (*ConversionResponse, error) {
+	out := new(ConversionResponse)
+	err := c.cc.Invoke(ctx, "/api.proto.v1alpha1.currency.converter.CurrencyConverterService/ConvertWithQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyConverterServiceClient) ConvertToMany(ctx context.Context, in *ConvertToManyRequest, opts ...grpc.CallOption) (*ConvertToManyResponse, error) {
+	out := new(ConvertToManyResponse)
+	err := c.cc.Invoke(ctx, "/api.proto.v1alpha1.currency.converter.CurrencyConverterService/ConvertToMany", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyConverterServiceClient) ConvertBasket(ctx context.Context, in *ConvertBasketRequest, opts ...grpc.CallOption) (*ConvertBasketResponse, error) {
+	out := new(ConvertBasketResponse)
+	err := c.cc.Invoke(ctx, "/api.proto.v1alpha1.currency.converter.CurrencyConverterService/ConvertBasket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *currencyConverterServiceClient) BatchConvert(ctx context.Context, in *BatchConversionRequest, opts ...grpc.CallOption) (*BatchConversionResponse, error) {
 	out := new(BatchConversionResponse)
 	err := c.cc.Invoke(ctx, "/api.proto.v1alpha1.currency.converter.CurrencyConverterService/BatchConvert", in, out, opts...)
@@ -57,6 +135,37 @@ func (c *currencyConverterServiceClient) BatchConvert(ctx context.Context, in *B
 	return out, nil
 }
 
+func (c *currencyConverterServiceClient) ConvertStream(ctx context.Context, opts ...grpc.CallOption) (CurrencyConverterService_ConvertStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CurrencyConverterService_ServiceDesc.Streams[0], "/api.proto.v1alpha1.currency.converter.CurrencyConverterService/ConvertStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &currencyConverterServiceConvertStreamClient{stream}
+	return x, nil
+}
+
+type CurrencyConverterService_ConvertStreamClient interface {
+	Send(*ConvertStreamRequest) error
+	Recv() (*ConvertStreamResponse, error)
+	grpc.ClientStream
+}
+
+type currencyConverterServiceConvertStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *currencyConverterServiceConvertStreamClient) Send(m *ConvertStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *currencyConverterServiceConvertStreamClient) Recv() (*ConvertStreamResponse, error) {
+	m := new(ConvertStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *currencyConverterServiceClient) ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesResponse, error) {
 	out := new(ListExchangeRatesResponse)
 	err := c.cc.Invoke(ctx, "/api.proto.v1alpha1.currency.converter.CurrencyConverterService/ListExchangeRates", in, out, opts...)
@@ -66,16 +175,108 @@ func (c *currencyConverterServiceClient) ListExchangeRates(ctx context.Context,
 	return out, nil
 }
 
+func (c *currencyConverterServiceClient) GetHistoricalRates(ctx context.Context, in *HistoricalRatesRequest, opts ...grpc.CallOption) (*HistoricalRatesResponse, error) {
+	out := new(HistoricalRatesResponse)
+	err := c.cc.Invoke(ctx, "/api.proto.v1alpha1.currency.converter.CurrencyConverterService/GetHistoricalRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyConverterServiceClient) GetRateTimeSeries(ctx context.Context, in *RateTimeSeriesRequest, opts ...grpc.CallOption) (*RateTimeSeriesResponse, error) {
+	out := new(RateTimeSeriesResponse)
+	err := c.cc.Invoke(ctx, "/api.proto.v1alpha1.currency.converter.CurrencyConverterService/GetRateTimeSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyConverterServiceClient) ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error) {
+	out := new(ListProvidersResponse)
+	err := c.cc.Invoke(ctx, "/api.proto.v1alpha1.currency.converter.CurrencyConverterService/ListProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyConverterServiceClient) ListCurrencies(ctx context.Context, in *ListCurrenciesRequest, opts ...grpc.CallOption) (*ListCurrenciesResponse, error) {
+	out := new(ListCurrenciesResponse)
+	err := c.cc.Invoke(ctx, "/api.proto.v1alpha1.currency.converter.CurrencyConverterService/ListCurrencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyConverterServiceClient) WatchExchangeRates(ctx context.Context, in *WatchExchangeRatesRequest, opts ...grpc.CallOption) (CurrencyConverterService_WatchExchangeRatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CurrencyConverterService_ServiceDesc.Streams[1], "/api.proto.v1alpha1.currency.converter.CurrencyConverterService/WatchExchangeRates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &currencyConverterServiceWatchExchangeRatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CurrencyConverterService_WatchExchangeRatesClient interface {
+	Recv() (*WatchExchangeRatesResponse, error)
+	grpc.ClientStream
+}
+
+type currencyConverterServiceWatchExchangeRatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *currencyConverterServiceWatchExchangeRatesClient) Recv() (*WatchExchangeRatesResponse, error) {
+	m := new(WatchExchangeRatesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // CurrencyConverterServiceServer is the server API for CurrencyConverterService service.
 // All implementations should embed UnimplementedCurrencyConverterServiceServer
 // for forward compatibility
 type CurrencyConverterServiceServer interface {
 	// Get currency conversion.
 	Convert(context.Context, *ConversionRequest) (*ConversionResponse, error)
+	// Get the amount of a currency to be converted to receive an exact amount of another currency.
+	ReverseConvert(context.Context, *ReverseConversionRequest) (*ConversionResponse, error)
+	// Get a currency conversion with the rates of every supported exchange provider, to compare them.
+	CompareConversion(context.Context, *CompareConversionRequest) (*CompareConversionResponse, error)
+	// Lock the exchange rate of a currency pair for a while, to convert with it once.
+	CreateQuote(context.Context, *CreateQuoteRequest) (*Quote, error)
+	// Get currency conversion with the rate locked by a quote, which can only be used once before it expires.
+	ConvertWithQuote(context.Context, *ConvertWithQuoteRequest) (*ConversionResponse, error)
+	// Get the conversions of one amount to several currencies.
+	ConvertToMany(context.Context, *ConvertToManyRequest) (*ConvertToManyResponse, error)
+	// Get the total of amounts in several currencies, converted to one currency.
+	ConvertBasket(context.Context, *ConvertBasketRequest) (*ConvertBasketResponse, error)
 	// Get currency conversions in batch.
 	BatchConvert(context.Context, *BatchConversionRequest) (*BatchConversionResponse, error)
+	// Convert a stream of currencies, every conversion sent back with the id of its request, as soon as it is converted.
+	ConvertStream(CurrencyConverterService_ConvertStreamServer) error
 	// List currency exchange rates.
 	ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error)
+	// Get the currency exchange rates of a past date.
+	GetHistoricalRates(context.Context, *HistoricalRatesRequest) (*HistoricalRatesResponse, error)
+	// Get the exchange rates of a currency pair over a time range, aggregated by day or by hour.
+	GetRateTimeSeries(context.Context, *RateTimeSeriesRequest) (*RateTimeSeriesResponse, error)
+	// List the supported exchange providers, with their capabilities and health.
+	ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error)
+	// List the currencies supported by an exchange provider.
+	ListCurrencies(context.Context, *ListCurrenciesRequest) (*ListCurrenciesResponse, error)
+	// Watch the exchange rates, pushed every time they change.
+	WatchExchangeRates(*WatchExchangeRatesRequest, CurrencyConverterService_WatchExchangeRatesServer) error
 }
 
 // UnimplementedCurrencyConverterServiceServer should be embedded to have forward compatible implementations.
@@ -85,12 +286,48 @@ type UnimplementedCurrencyConverterServiceServer struct {
 func (UnimplementedCurrencyConverterServiceServer) Convert(context.Context, *ConversionRequest) (*ConversionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Convert not implemented")
 }
+func (UnimplementedCurrencyConverterServiceServer) ReverseConvert(context.Context, *ReverseConversionRequest) (*ConversionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseConvert not implemented")
+}
+func (UnimplementedCurrencyConverterServiceServer) CompareConversion(context.Context, *CompareConversionRequest) (*CompareConversionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareConversion not implemented")
+}
+func (UnimplementedCurrencyConverterServiceServer) CreateQuote(context.Context, *CreateQuoteRequest) (*Quote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQuote not implemented")
+}
+func (UnimplementedCurrencyConverterServiceServer) ConvertWithQuote(context.Context, *ConvertWithQuoteRequest) (*ConversionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertWithQuote not implemented")
+}
+func (UnimplementedCurrencyConverterServiceServer) ConvertToMany(context.Context, *ConvertToManyRequest) (*ConvertToManyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertToMany not implemented")
+}
+func (UnimplementedCurrencyConverterServiceServer) ConvertBasket(context.Context, *ConvertBasketRequest) (*ConvertBasketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertBasket not implemented")
+}
 func (UnimplementedCurrencyConverterServiceServer) BatchConvert(context.Context, *BatchConversionRequest) (*BatchConversionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchConvert not implemented")
 }
+func (UnimplementedCurrencyConverterServiceServer) ConvertStream(CurrencyConverterService_ConvertStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ConvertStream not implemented")
+}
 func (UnimplementedCurrencyConverterServiceServer) ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListExchangeRates not implemented")
 }
+func (UnimplementedCurrencyConverterServiceServer) GetHistoricalRates(context.Context, *HistoricalRatesRequest) (*HistoricalRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistoricalRates not implemented")
+}
+func (UnimplementedCurrencyConverterServiceServer) GetRateTimeSeries(context.Context, *RateTimeSeriesRequest) (*RateTimeSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRateTimeSeries not implemented")
+}
+func (UnimplementedCurrencyConverterServiceServer) ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProviders not implemented")
+}
+func (UnimplementedCurrencyConverterServiceServer) ListCurrencies(context.Context, *ListCurrenciesRequest) (*ListCurrenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCurrencies not implemented")
+}
+func (UnimplementedCurrencyConverterServiceServer) WatchExchangeRates(*WatchExchangeRatesRequest, CurrencyConverterService_WatchExchangeRatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchExchangeRates not implemented")
+}
 
 // UnsafeCurrencyConverterServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to CurrencyConverterServiceServer will
@@ -121,6 +358,114 @@ func _CurrencyConverterService_Convert_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CurrencyConverterService_ReverseConvert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseConversionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyConverterServiceServer).ReverseConvert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.proto.v1alpha1.currency.converter.CurrencyConverterService/ReverseConvert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyConverterServiceServer).ReverseConvert(ctx, req.(*ReverseConversionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyConverterService_CompareConversion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareConversionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyConverterServiceServer).CompareConversion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.proto.v1alpha1.currency.converter.CurrencyConverterService/CompareConversion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyConverterServiceServer).CompareConversion(ctx, req.(*CompareConversionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyConverterService_CreateQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyConverterServiceServer).CreateQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.proto.v1alpha1.currency.converter.CurrencyConverterService/CreateQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyConverterServiceServer).CreateQuote(ctx, req.(*CreateQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyConverterService_ConvertWithQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertWithQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyConverterServiceServer).ConvertWithQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.proto.v1alpha1.currency.converter.CurrencyConverterService/ConvertWithQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyConverterServiceServer).ConvertWithQuote(ctx, req.(*ConvertWithQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyConverterService_ConvertToMany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertToManyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyConverterServiceServer).ConvertToMany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.proto.v1alpha1.currency.converter.CurrencyConverterService/ConvertToMany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyConverterServiceServer).ConvertToMany(ctx, req.(*ConvertToManyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyConverterService_ConvertBasket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertBasketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyConverterServiceServer).ConvertBasket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.proto.v1alpha1.currency.converter.CurrencyConverterService/ConvertBasket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyConverterServiceServer).ConvertBasket(ctx, req.(*ConvertBasketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CurrencyConverterService_BatchConvert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BatchConversionRequest)
 	if err := dec(in); err != nil {
@@ -139,6 +484,32 @@ func _CurrencyConverterService_BatchConvert_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CurrencyConverterService_ConvertStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CurrencyConverterServiceServer).ConvertStream(&currencyConverterServiceConvertStreamServer{stream})
+}
+
+type CurrencyConverterService_ConvertStreamServer interface {
+	Send(*ConvertStreamResponse) error
+	Recv() (*ConvertStreamRequest, error)
+	grpc.ServerStream
+}
+
+type currencyConverterServiceConvertStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *currencyConverterServiceConvertStreamServer) Send(m *ConvertStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *currencyConverterServiceConvertStreamServer) Recv() (*ConvertStreamRequest, error) {
+	m := new(ConvertStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _CurrencyConverterService_ListExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListExchangeRatesRequest)
 	if err := dec(in); err != nil {
@@ -157,6 +528,99 @@ func _CurrencyConverterService_ListExchangeRates_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CurrencyConverterService_GetHistoricalRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoricalRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyConverterServiceServer).GetHistoricalRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.proto.v1alpha1.currency.converter.CurrencyConverterService/GetHistoricalRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyConverterServiceServer).GetHistoricalRates(ctx, req.(*HistoricalRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyConverterService_GetRateTimeSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateTimeSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyConverterServiceServer).GetRateTimeSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.proto.v1alpha1.currency.converter.CurrencyConverterService/GetRateTimeSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyConverterServiceServer).GetRateTimeSeries(ctx, req.(*RateTimeSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyConverterService_ListProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyConverterServiceServer).ListProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.proto.v1alpha1.currency.converter.CurrencyConverterService/ListProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyConverterServiceServer).ListProviders(ctx, req.(*ListProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyConverterService_ListCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCurrenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyConverterServiceServer).ListCurrencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.proto.v1alpha1.currency.converter.CurrencyConverterService/ListCurrencies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyConverterServiceServer).ListCurrencies(ctx, req.(*ListCurrenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyConverterService_WatchExchangeRates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchExchangeRatesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CurrencyConverterServiceServer).WatchExchangeRates(m, &currencyConverterServiceWatchExchangeRatesServer{stream})
+}
+
+type CurrencyConverterService_WatchExchangeRatesServer interface {
+	Send(*WatchExchangeRatesResponse) error
+	grpc.ServerStream
+}
+
+type currencyConverterServiceWatchExchangeRatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *currencyConverterServiceWatchExchangeRatesServer) Send(m *WatchExchangeRatesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // CurrencyConverterService_ServiceDesc is the grpc.ServiceDesc for CurrencyConverterService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -168,6 +632,30 @@ var CurrencyConverterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Convert",
 			Handler:    _CurrencyConverterService_Convert_Handler,
 		},
+		{
+			MethodName: "ReverseConvert",
+			Handler:    _CurrencyConverterService_ReverseConvert_Handler,
+		},
+		{
+			MethodName: "CompareConversion",
+			Handler:    _CurrencyConverterService_CompareConversion_Handler,
+		},
+		{
+			MethodName: "CreateQuote",
+			Handler:    _CurrencyConverterService_CreateQuote_Handler,
+		},
+		{
+			MethodName: "ConvertWithQuote",
+			Handler:    _CurrencyConverterService_ConvertWithQuote_Handler,
+		},
+		{
+			MethodName: "ConvertToMany",
+			Handler:    _CurrencyConverterService_ConvertToMany_Handler,
+		},
+		{
+			MethodName: "ConvertBasket",
+			Handler:    _CurrencyConverterService_ConvertBasket_Handler,
+		},
 		{
 			MethodName: "BatchConvert",
 			Handler:    _CurrencyConverterService_BatchConvert_Handler,
@@ -176,7 +664,35 @@ var CurrencyConverterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListExchangeRates",
 			Handler:    _CurrencyConverterService_ListExchangeRates_Handler,
 		},
+		{
+			MethodName: "GetHistoricalRates",
+			Handler:    _CurrencyConverterService_GetHistoricalRates_Handler,
+		},
+		{
+			MethodName: "GetRateTimeSeries",
+			Handler:    _CurrencyConverterService_GetRateTimeSeries_Handler,
+		},
+		{
+			MethodName: "ListProviders",
+			Handler:    _CurrencyConverterService_ListProviders_Handler,
+		},
+		{
+			MethodName: "ListCurrencies",
+			Handler:    _CurrencyConverterService_ListCurrencies_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ConvertStream",
+			Handler:       _CurrencyConverterService_ConvertStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchExchangeRates",
+			Handler:       _CurrencyConverterService_WatchExchangeRates_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1alpha1/currencyconverter/currency_converter_server.proto",
 }
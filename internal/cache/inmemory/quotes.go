@@ -0,0 +1,89 @@
+package inmemory
+
+import (
+	"sync"
+	"time"
+
+	"currency-converter/internal/cache"
+	apierrs "currency-converter/internal/errors"
+)
+
+var _ cache.QuoteStore = (*quotes)(nil)
+
+// quoteEntry is a quote of the quotes store, with whether it was used.
+type quoteEntry struct {
+	quote *cache.Quote
+	used  bool
+}
+
+// quotes is the in-memory quote store.
+type quotes struct {
+	items map[string]*quoteEntry
+	mu    *sync.Mutex
+}
+
+// NewQuoteStore is a constructor for the in-memory quote store.
+func NewQuoteStore() cache.QuoteStore {
+	return &quotes{
+		items: map[string]*quoteEntry{},
+		mu:    &sync.Mutex{},
+	}
+}
+
+func (store *quotes) SetQuote(quote *cache.Quote) error {
+	if quote == nil || quote.ID == "" {
+		return apierrs.InvalidArgumentError
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.items[quote.ID] = &quoteEntry{quote: quote}
+
+	return nil
+}
+
+func (store *quotes) GetQuote(id string) (*cache.Quote, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	e, present := store.items[id]
+	if !present {
+		return nil, apierrs.QuoteNotFoundError(id)
+	}
+
+	return e.quote, nil
+}
+
+// UseQuote marks the quote as used, so that a concurrent use of the same quote fails.
+func (store *quotes) UseQuote(id string, at time.Time) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	e, present := store.items[id]
+	switch {
+	case !present:
+		return apierrs.QuoteNotFoundError(id)
+	case e.used:
+		return apierrs.QuoteUsedError(id)
+	case e.quote.IsExpired(at):
+		return apierrs.QuoteExpiredError(id)
+	}
+
+	e.used = true
+
+	return nil
+}
+
+// CleanupAllExpired will delete all the expired quotes, used or not.
+func (store *quotes) CleanupAllExpired() {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	now := time.Now()
+	for id, e := range store.items {
+		if e.quote.IsExpired(now) {
+			delete(store.items, id)
+		}
+	}
+}
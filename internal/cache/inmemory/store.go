@@ -3,15 +3,14 @@ package inmemory
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 	"time"
 
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
 
 	"currency-converter/internal/cache"
+	"currency-converter/internal/config"
 	apierrs "currency-converter/internal/errors"
 	"currency-converter/internal/exchange"
 	"currency-converter/internal/factory"
@@ -59,13 +58,29 @@ func (e *entry) IsExpired() bool {
 type inMemory struct {
 	items map[string]*entry
 	mu    *sync.RWMutex
+
+	// settings holds the validity of the exchange rates and the available currencies lists.
+	settings *config.Holder
+
+	// reporter is notified of the outcome of every live rates fetch.
+	reporter cache.ProviderReporter
+
+	// recorders are notified of every rates table fetched.
+	recorders []cache.RatesRecorder
+
+	// version is the last version assigned to a rates table, guarded by mu.
+	version uint64
 }
 
 // NewStore is a constructor for inMemory cache store.
-func NewStore() cache.Store {
+// The TTLs of the entries are read from the current configuration of settings, when the entries are set.
+func NewStore(settings *config.Holder, reporter cache.ProviderReporter, recorders ...cache.RatesRecorder) cache.Store {
 	return &inMemory{
-		items: map[string]*entry{},
-		mu:    &sync.RWMutex{},
+		items:     map[string]*entry{},
+		mu:        &sync.RWMutex{},
+		settings:  settings,
+		reporter:  reporter,
+		recorders: recorders,
 	}
 }
 
@@ -73,9 +88,10 @@ func NewStore() cache.Store {
 // Updates the cache for cache miss.
 func (store *inMemory) AvailableCurrencies(exchangeProvider exchange.ProviderType) ([]string, error) {
 	store.mu.RLock()
-	defer store.mu.RUnlock()
+	val, present := store.items[string(exchangeProvider)]
+	store.mu.RUnlock()
 
-	if val, present := store.items[string(exchangeProvider)]; present {
+	if present {
 		return val.data.([]string), nil
 	}
 
@@ -84,6 +100,10 @@ func (store *inMemory) AvailableCurrencies(exchangeProvider exchange.ProviderTyp
 
 	// cache miss, update the cache and return the value
 	provider := factory.NewExchangeRatesProviderFactory().BuildExchangeRatesProvider(exchangeProvider)
+	if provider == nil {
+		return []string{}, apierrs.UnsupportedProviderError(string(exchangeProvider))
+	}
+
 	if currencies, err = provider.Currencies(); err != nil {
 		return []string{}, err
 	}
@@ -100,42 +120,50 @@ func (store *inMemory) SetAvailableCurrencies(exchangeProvider exchange.Provider
 	store.mu.Lock()
 	defer store.mu.Unlock()
 
-	store.items[string(exchangeProvider)] = newStoreEntry(currencyCodes, 2*7*24*time.Hour) // 2 weeks of validity
+	store.items[string(exchangeProvider)] = newStoreEntry(currencyCodes, store.settings.Get().Cache.CurrenciesTTL)
 
 	return nil
 }
 
 // GetExchangeRate returns exchange rate for the passed currency code for the exchange provider.
 func (store *inMemory) GetExchangeRate(currencyCode string, exchangeProvider exchange.ProviderType) (float32, error) {
-	store.mu.RLock()
-	defer store.mu.RUnlock()
-
 	key := cache.GetKey(currencyCode, exchangeProvider)
 
-	if val, present := store.items[key]; present {
+	store.mu.RLock()
+	val, present := store.items[key]
+	store.mu.RUnlock()
+
+	if present {
 		return val.data.(float32), nil
 	}
 
 	// cache MISS: refresh rates in cache
 	provider := factory.NewExchangeRatesProviderFactory().BuildExchangeRatesProvider(exchangeProvider)
+	if provider == nil {
+		return -1, apierrs.UnsupportedProviderError(string(exchangeProvider))
+	}
 
 	var err error
 	var rates map[string]float32
 
 	if rates, err = provider.LiveRates(); err != nil {
+		store.reporter.ReportFailure(exchangeProvider, err)
 		return -1, apierrs.UpstreamExchangeRateServerError
 	}
 
-	if err = store.SetExchangeRate(currencyCode, exchangeProvider, rates[currencyCode], cache.DefaultExpiration); err != nil {
+	store.reporter.ReportSuccess(exchangeProvider)
+
+	// all the live rates are fetched anyway, store them all.
+	if err = store.setLiveRates(exchangeProvider, exchange.BaseCurrency, rates); err != nil {
 		return -1, apierrs.InternalCacheError
 	}
 
-	val, present := rates[currencyCode]
+	rate, present := rates[currencyCode]
 	if !present {
 		return -1, apierrs.CacheKeyNotFoundError
 	}
 
-	return val, nil
+	return rate, nil
 }
 
 func (store *inMemory) SetExchangeRate(
@@ -153,62 +181,174 @@ func (store *inMemory) SetExchangeRate(
 	return nil
 }
 
+// GetRates returns the rates table of the exchange provider against the base currency.
+func (store *inMemory) GetRates(exchangeProvider exchange.ProviderType, base string) (*cache.Rates, error) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	val, present := store.items[cache.GetRatesKey(exchangeProvider, base)]
+	if !present || val.IsExpired() {
+		return nil, apierrs.CacheKeyNotFoundError
+	}
+
+	return val.data.(*cache.Rates), nil
+}
+
+// GetRatesVersion returns the version of the rates table of the exchange provider against the base currency.
+func (store *inMemory) GetRatesVersion(exchangeProvider exchange.ProviderType, base string, version uint64) (*cache.Rates, error) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	val, present := store.items[cache.GetRatesVersionKey(exchangeProvider, base, version)]
+	if !present || val.IsExpired() {
+		return nil, apierrs.CacheKeyNotFoundError
+	}
+
+	return val.data.(*cache.Rates), nil
+}
+
+// SetRates sets the rates table of the exchange provider against its base currency.
+// The table is also kept by version until it expires, for the readers which pinned it.
+func (store *inMemory) SetRates(exchangeProvider exchange.ProviderType, rates *cache.Rates, expiration time.Duration) error {
+	if rates == nil || rates.Base == "" {
+		return apierrs.InvalidArgumentError
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	// the version is assigned with the table stored, so that the live table always has the latest version.
+	store.version++
+	rates.Version = store.version
+
+	e := newStoreEntry(rates, expiration)
+	store.items[cache.GetRatesKey(exchangeProvider, rates.Base)] = e
+	store.items[cache.GetRatesVersionKey(exchangeProvider, rates.Base, rates.Version)] = e
+
+	return nil
+}
+
+// setLiveRates stores the live rates fetched from the exchange provider against the base currency,
+// as a rates table and, against BaseCurrency, as the rate of every currency code. The rates table is recorded.
+func (store *inMemory) setLiveRates(exchangeProvider exchange.ProviderType, base string, rates map[string]float32) error {
+	ratesTTL := store.settings.Get().Cache.RatesTTL
+
+	if base == exchange.BaseCurrency {
+		for code, rate := range rates {
+			if err := store.SetExchangeRate(code, exchangeProvider, rate, ratesTTL); err != nil {
+				return err
+			}
+		}
+	}
+
+	table := &cache.Rates{Base: base, Values: rates, FetchedAt: time.Now()}
+	if err := store.SetRates(exchangeProvider, table, ratesTTL); err != nil {
+		return err
+	}
+
+	for _, recorder := range store.recorders {
+		if err := recorder.Record(exchangeProvider, table); err != nil {
+			// the rates are served anyway.
+			logrus.WithError(err).Warnf("failed to record the rates against [%s] of the provider [%s]", base, exchangeProvider)
+		}
+	}
+
+	return nil
+}
+
+// refreshPivotRates fetches the live rates of the exchange provider against the configured pivot currencies,
+// when the provider can quote against other bases than BaseCurrency.
+// A pivot which cannot be fetched is skipped, the pairs can still be triangulated through the others.
+func (store *inMemory) refreshPivotRates(exchangeProvider exchange.ProviderType, provider exchange.Provider, logger *logrus.Logger) {
+	quoter, ok := provider.(exchange.BaseQuoter)
+	if !ok {
+		return
+	}
+
+	for _, pivot := range store.settings.Get().Conversion.Pivots {
+		if pivot == exchange.BaseCurrency {
+			continue
+		}
+
+		rates, err := quoter.LiveRatesAgainst(pivot)
+		if err != nil {
+			logger.WithError(err).Warnf("error while fetching live rates against [%s] from the provider: [%s]", pivot, exchangeProvider)
+			continue
+		}
+
+		if err = store.setLiveRates(exchangeProvider, pivot, rates); err != nil {
+			logger.WithError(err).Warnf("failed to set the rates against [%s] for provider [%s]", pivot, exchangeProvider)
+		}
+	}
+}
+
 func (store *inMemory) RefreshExchangeRates(providers []exchange.ProviderType) error {
 	logger := logrus.New()
 
+	// updated counts the providers whose live rates are all stored in the cache.
+	var updated int32
+
+	// the providers are all built first, an unsupported provider fails the refresh before any fetch.
+	built := make(map[exchange.ProviderType]exchange.Provider, len(providers))
+	for _, providerType := range providers {
+		provider := factory.NewExchangeRatesProviderFactory().BuildExchangeRatesProvider(providerType)
+		if provider == nil {
+			return apierrs.UnsupportedProviderError(string(providerType))
+		}
+
+		built[providerType] = provider
+	}
+
 	g, _ := errgroup.WithContext(context.Background())
 
 	for _, providerType := range providers {
 		exchangeProvider := providerType
 		g.Go(func() error {
-			store.mu.Lock()
-			defer store.mu.Unlock()
-
-			provider := factory.NewExchangeRatesProviderFactory().BuildExchangeRatesProvider(exchangeProvider)
+			provider := built[exchangeProvider]
 
 			var err error
 			var rates map[string]float32
 
 			if rates, err = provider.LiveRates(); err != nil {
 				logger.WithError(err).Warnf("error while fetching live rates from the provider: [%s]", exchangeProvider)
+				store.reporter.ReportFailure(exchangeProvider, err)
 
 				// returning with nil error as we want just one successful hit of the LiveRates from any of the passed provider.
 				return nil
 			}
 
-			for code, rate := range rates {
-				if tErr := store.SetExchangeRate(code, exchangeProvider, rate, cache.DefaultExpiration); tErr != nil {
-					logger.WithError(err).
-						Warnf("failed to set exchangerate for currency [%s] and provider [%s]", code, exchangeProvider)
+			store.reporter.ReportSuccess(exchangeProvider)
 
-					err = tErr
-				}
-			}
+			if err = store.setLiveRates(exchangeProvider, exchange.BaseCurrency, rates); err != nil {
+				logger.WithError(err).Warnf("failed to set exchange rates for provider [%s]", exchangeProvider)
 
-			if err != nil {
-				// we send error only when we have the successful completion of live rates update.
 				return nil
 			}
 
-			return status.Error(codes.OK, "error for sending the signal of first successful live rates updates")
+			atomic.AddInt32(&updated, 1)
+
+			store.refreshPivotRates(exchangeProvider, provider, logger)
+
+			return nil
 		})
 	}
 
-	err := g.Wait()
-	if err != nil && status.Code(err) == codes.OK {
-		logger.Info("Successful live rates are updated by the one provider")
+	_ = g.Wait()
 
-		return nil
+	if atomic.LoadInt32(&updated) == 0 {
+		logger.Error("Refresher workers stopped. No workers could update exchange rates from the given list of providers")
+		return apierrs.UpstreamExchangeRateServerError
 	}
 
-	logger.Error("Refresher workers stopped. No workers could update exchange rates from the given list of providers")
-	return err
+	logger.Infof("Successful live rates are updated by [%d] providers", updated)
+
+	return nil
 }
 
 // CleanupAllExpired will delete all the expired entries.
 func (store *inMemory) CleanupAllExpired() {
 	store.mu.Lock()
-	defer store.mu.Lock()
+	defer store.mu.Unlock()
 
 	for key, cacheEntry := range store.items {
 		if !cacheEntry.IsExpired() {
@@ -0,0 +1,50 @@
+package inmemory
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"currency-converter/internal/cache"
+	"currency-converter/internal/config"
+	"currency-converter/internal/health"
+)
+
+func TestSetRatesKeepsTheLatestVersionLive(t *testing.T) {
+	settings := config.NewHolder(config.Default())
+	store := NewStore(settings, health.NewMonitor(settings))
+	exProvider := settings.Get().Exchange.DefaultProvider
+
+	const tables = 100
+
+	var wg sync.WaitGroup
+	for i := 0; i < tables; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			rates := &cache.Rates{Base: "USD", Values: map[string]float32{"EUR": 0.9}, FetchedAt: time.Now()}
+			if err := store.SetRates(exProvider, rates, time.Hour); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	live, err := store.GetRates(exProvider, "USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if live.Version != tables {
+		t.Errorf("got the live version %d, want %d", live.Version, tables)
+	}
+
+	for version := uint64(1); version <= tables; version++ {
+		rates, err := store.GetRatesVersion(exProvider, "USD", version)
+		if err != nil || rates.Version != version {
+			t.Errorf("got the table [%v] and the error [%v] for the version %d", rates, err, version)
+		}
+	}
+}
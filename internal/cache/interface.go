@@ -10,7 +10,13 @@ import (
 	"currency-converter/internal/exchange"
 )
 
-const DefaultExpiration = 2 * time.Minute
+const (
+	// DefaultExpiration is the default validity of an exchange rate.
+	DefaultExpiration = 2 * time.Minute
+
+	// DefaultCurrenciesExpiration is the default validity of the list of available currencies of a provider.
+	DefaultCurrenciesExpiration = 2 * 7 * 24 * time.Hour // 2 weeks
+)
 
 // Store holds the currency exchange rates for an exchange provider with the currency code.
 type Store interface {
@@ -25,13 +31,28 @@ type Store interface {
 	GetExchangeRate(currencyCode string, exchangeProvider exchange.ProviderType) (float32, error)
 
 	// SetExchangeRate sets the exchange rate for a key from exchange provider and the currency code.
-	// By default, each rate will have an expiration of 2 minutes.
+	// By default, each rate will have an expiration of DefaultExpiration.
 	SetExchangeRate(currencyCode string, exchangeProvider exchange.ProviderType, rate float32, expiration time.Duration) error
 
+	// GetRates returns the exchange rates of the provider quoted against the base currency, as a whole.
+	// returns NotFound error if the provider has no rates cached against the base currency.
+	GetRates(exchangeProvider exchange.ProviderType, base string) (*Rates, error)
+
+	// GetRatesVersion returns the rates table of the provider against the base currency with the version,
+	// even if more recent rates were set since, until it expires.
+	// returns NotFound error once the rates table is evicted.
+	GetRatesVersion(exchangeProvider exchange.ProviderType, base string, version uint64) (*Rates, error)
+
+	// SetRates sets the exchange rates of the provider quoted against their base currency,
+	// and assigns them a new Version.
+	SetRates(exchangeProvider exchange.ProviderType, rates *Rates, expiration time.Duration) error
+
 	// RefreshExchangeRates fetches the latest exchange rates from all the supported exchange rates providers.
 	// Will be used to refresh rates at:
 	// 1. "Cache Miss" in a request for that provider.
 	// 2. Every 5 minute refresh.
+	// The rates against the configured pivot currencies are fetched too, from the providers implementing exchange.BaseQuoter.
+	// Returns an error only when none of the providers could be refreshed.
 	RefreshExchangeRates(providers []exchange.ProviderType) error
 
 	// CleanupAllExpired will cleanup all the entries which are expired.
@@ -39,6 +60,37 @@ type Store interface {
 	CleanupAllExpired()
 }
 
+// Rates is a table of exchange rates of a provider, all quoted against the same base currency.
+// It must not be modified once stored.
+type Rates struct {
+	// Base is the currency the rates are quoted against, e.g. USD.
+	Base string
+
+	// Values holds, for every currency code, the amount of that currency worth one unit of the base currency.
+	Values map[string]float32
+
+	// FetchedAt is the time at which the rates were fetched from the provider.
+	FetchedAt time.Time
+
+	// Version identifies the rates table, it is assigned by the store and increases every time rates are set.
+	Version uint64
+}
+
+// ProviderReporter is notified of the outcome of every live rates fetch from an exchange provider.
+type ProviderReporter interface {
+	// ReportSuccess is called when the live rates of the provider are fetched successfully.
+	ReportSuccess(exchangeProvider exchange.ProviderType)
+
+	// ReportFailure is called when the live rates of the provider could not be fetched.
+	ReportFailure(exchangeProvider exchange.ProviderType, err error)
+}
+
+// RatesRecorder is notified of every rates table fetched from an exchange provider, e.g. to keep their history.
+type RatesRecorder interface {
+	// Record is called with every rates table fetched from the provider. The rates must not be modified.
+	Record(exchangeProvider exchange.ProviderType, rates *Rates) error
+}
+
 // GetKey is the constructor for cache key using exchange provider and the currency code.
 // It will be used to set and get the rates.
 func GetKey(currencyCode string, exchangeProvider exchange.ProviderType) string {
@@ -53,3 +105,34 @@ func GetKey(currencyCode string, exchangeProvider exchange.ProviderType) string
 	md5Sum := md5.Sum(jsonBytes)
 	return fmt.Sprintf("%x", md5Sum[:])
 }
+
+// GetRatesKey is the constructor for cache key of the rates table of an exchange provider against a base currency.
+func GetRatesKey(exchangeProvider exchange.ProviderType, base string) string {
+	jsonBytes, _ := json.Marshal(struct {
+		Provider string
+		Base     string
+	}{
+		Provider: string(exchangeProvider),
+		Base:     base,
+	})
+	//nolint:gosec
+	md5Sum := md5.Sum(jsonBytes)
+	return fmt.Sprintf("%x", md5Sum[:])
+}
+
+// GetRatesVersionKey is the constructor for cache key of a version of the rates table of an exchange provider
+// against a base currency.
+func GetRatesVersionKey(exchangeProvider exchange.ProviderType, base string, version uint64) string {
+	jsonBytes, _ := json.Marshal(struct {
+		Provider string
+		Base     string
+		Version  uint64
+	}{
+		Provider: string(exchangeProvider),
+		Base:     base,
+		Version:  version,
+	})
+	//nolint:gosec
+	md5Sum := md5.Sum(jsonBytes)
+	return fmt.Sprintf("%x", md5Sum[:])
+}
@@ -0,0 +1,61 @@
+package cache
+
+import (
+	"time"
+
+	"currency-converter/internal/exchange"
+	"currency-converter/pkg/converter"
+	"currency-converter/pkg/pricing"
+)
+
+// QuoteStore holds the quotes, the exchange rates locked for a client until they expire or are used.
+type QuoteStore interface {
+	// SetQuote stores the quote until it expires.
+	SetQuote(quote *Quote) error
+
+	// GetQuote returns the quote with the id.
+	// returns NotFound error if the quote is unknown, or evicted once expired.
+	GetQuote(id string) (*Quote, error)
+
+	// UseQuote marks the quote with the id as used, as long as it is neither used nor expired at the time.
+	// returns NotFound error if the quote is unknown, FailedPrecondition error if it is used or expired.
+	UseQuote(id string, at time.Time) error
+
+	// CleanupAllExpired will cleanup all the quotes which are expired.
+	CleanupAllExpired()
+}
+
+// Quote is an exchange rate locked for a client. It must not be modified once stored.
+type Quote struct {
+	// ID identifies the quote, it is assigned by its creator.
+	ID string
+
+	// ClientID is the client the quote was created for. Empty when not identified.
+	ClientID string
+
+	// Provider is the exchange provider of the rate.
+	Provider exchange.ProviderType
+
+	// From and To are the currency codes the rate converts from and to.
+	From, To string
+
+	// MidRate is the locked mid-market rate.
+	MidRate converter.Decimal
+
+	// Path holds the currencies the rate was derived through, from From to To.
+	Path []string
+
+	// RatesFetchedAt is the time at which the rates the rate was derived from were fetched.
+	RatesFetchedAt time.Time
+
+	// Rule is the pricing rule locked with the rate. Nil when none applies.
+	Rule *pricing.Rule
+
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// IsExpired returns true if the quote is expired at the time.
+func (quote *Quote) IsExpired(at time.Time) bool {
+	return !at.Before(quote.ExpiresAt)
+}
@@ -0,0 +1,479 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"currency-converter/internal/cache"
+	"currency-converter/internal/exchange"
+	"currency-converter/pkg/converter"
+	"currency-converter/pkg/currency"
+	"currency-converter/pkg/pricing"
+)
+
+// EnvPrefix is the prefix of all the environment variables read by the configuration.
+const EnvPrefix = "CONVERTER_"
+
+// Config holds all the tunables of the service.
+type Config struct {
+	Server     Server     `yaml:"server"`
+	Cache      Cache      `yaml:"cache"`
+	Jobs       Jobs       `yaml:"jobs"`
+	Exchange   Exchange   `yaml:"exchange"`
+	Health     Health     `yaml:"health"`
+	Conversion Conversion `yaml:"conversion"`
+	Pricing    Pricing    `yaml:"pricing"`
+	History    History    `yaml:"history"`
+}
+
+// Server holds the listen addresses of the gRPC server and the REST gateway.
+type Server struct {
+	GRPCAddr        string        `yaml:"grpcAddr"`
+	HTTPAddr        string        `yaml:"httpAddr"`
+	ShutdownTimeout time.Duration `yaml:"shutdownTimeout"`
+
+	// WatchHeartbeat is the interval of the heartbeats sent on the idle exchange rates watches.
+	WatchHeartbeat time.Duration `yaml:"watchHeartbeat"`
+}
+
+// Cache holds the validity of the entries stored in the cache.
+type Cache struct {
+	// RatesTTL is the validity of an exchange rate.
+	RatesTTL time.Duration `yaml:"ratesTTL"`
+
+	// CurrenciesTTL is the validity of the list of available currencies of a provider.
+	CurrenciesTTL time.Duration `yaml:"currenciesTTL"`
+}
+
+// Jobs holds the intervals at which the background jobs run.
+type Jobs struct {
+	RefreshInterval time.Duration `yaml:"refreshInterval"`
+	CleanupInterval time.Duration `yaml:"cleanupInterval"`
+}
+
+// Exchange holds the exchange rates providers to be used.
+type Exchange struct {
+	// DefaultProvider is used when a request does not specify the exchange provider.
+	DefaultProvider exchange.ProviderType `yaml:"defaultProvider"`
+
+	// Providers are refreshed by the exchange rates refresher.
+	Providers []exchange.ProviderType `yaml:"providers"`
+}
+
+// Health holds the thresholds of the readiness and liveness checks.
+type Health struct {
+	// CheckInterval is the interval at which the gRPC health status is updated.
+	CheckInterval time.Duration `yaml:"checkInterval"`
+
+	// ProvidersFailureWindow is how long all the providers can fail before the service is not ready anymore.
+	ProvidersFailureWindow time.Duration `yaml:"providersFailureWindow"`
+
+	// RefresherStallTimeout is how long a refresh can overrun the refresh interval
+	// before the exchange rates refresher is considered wedged and the service not live.
+	RefresherStallTimeout time.Duration `yaml:"refresherStallTimeout"`
+}
+
+// Conversion holds the defaults of the conversions.
+type Conversion struct {
+	// DefaultRoundingMode is used when a request does not specify the rounding mode, e.g. "half-even".
+	DefaultRoundingMode converter.RoundingMode `yaml:"defaultRoundingMode"`
+
+	// Pivots are the currencies, in order of preference, through which a pair is triangulated
+	// when it cannot be derived from the rates against a single base currency, e.g. USD, EUR and BTC.
+	Pivots []string `yaml:"pivots"`
+
+	// MaxBatchSize is the maximum number of conversions of a batch.
+	MaxBatchSize int `yaml:"maxBatchSize"`
+
+	// MaxStreamInFlight is the maximum number of conversions of a stream converted at once.
+	// The stream is not read further until one of them is sent back.
+	MaxStreamInFlight int `yaml:"maxStreamInFlight"`
+
+	// CompareTimeout bounds the time a comparison waits for the rates of a provider which are not cached.
+	CompareTimeout time.Duration `yaml:"compareTimeout"`
+
+	// QuoteTTL is the validity of a quote, the time its rate is locked for.
+	QuoteTTL time.Duration `yaml:"quoteTTL"`
+}
+
+// Pricing holds the margins charged on top of the mid-market rates. It is only read from the YAML file.
+type Pricing struct {
+	// Rules are matched against every conversion, the most specific matching rule applies.
+	// No margin is charged on the conversions which match no rule.
+	Rules []pricing.Rule `yaml:"rules"`
+}
+
+// History holds where and how long the rates of every refresh are kept.
+type History struct {
+	// Dir is the directory the rates are persisted in.
+	Dir string `yaml:"dir"`
+
+	// Retention is how long the rates are kept.
+	Retention time.Duration `yaml:"retention"`
+}
+
+// Default returns the configuration used when nothing is overridden.
+func Default() *Config {
+	return &Config{
+		Server: Server{
+			GRPCAddr:        ":9090",
+			HTTPAddr:        ":8080",
+			ShutdownTimeout: 30 * time.Second,
+			WatchHeartbeat:  15 * time.Second,
+		},
+		Cache: Cache{
+			RatesTTL:      cache.DefaultExpiration,
+			CurrenciesTTL: cache.DefaultCurrenciesExpiration,
+		},
+		Jobs: Jobs{
+			RefreshInterval: 5 * time.Minute,
+			CleanupInterval: 5 * time.Minute,
+		},
+		Exchange: Exchange{
+			DefaultProvider: exchange.CurrencyLayer,
+			Providers:       exchange.GetSupportedProviders(),
+		},
+		Health: Health{
+			CheckInterval:          5 * time.Second,
+			ProvidersFailureWindow: 15 * time.Minute,
+			RefresherStallTimeout:  2 * time.Minute,
+		},
+		Conversion: Conversion{
+			DefaultRoundingMode: converter.HalfEven,
+			Pivots:              []string{exchange.BaseCurrency, "EUR", "BTC"},
+			MaxBatchSize:        100,
+			MaxStreamInFlight:   64,
+			CompareTimeout:      3 * time.Second,
+			QuoteTTL:            30 * time.Second,
+		},
+		History: History{
+			Dir:       "data/history",
+			Retention: 400 * 24 * time.Hour,
+		},
+	}
+}
+
+// setting is a single tunable which can be overridden from the environment and the command line.
+type setting struct {
+	flag  string
+	usage string
+	set   func(cfg *Config, value string) error
+}
+
+// EnvName returns the environment variable of the setting, e.g. "grpc-addr" is read from CONVERTER_GRPC_ADDR.
+func (s setting) EnvName() string {
+	return EnvPrefix + strings.ToUpper(strings.ReplaceAll(s.flag, "-", "_"))
+}
+
+var settings = []setting{
+	{
+		flag:  "grpc-addr",
+		usage: "address the gRPC server listens on",
+		set:   setString(func(c *Config) *string { return &c.Server.GRPCAddr }),
+	},
+	{
+		flag:  "http-addr",
+		usage: "address the REST gateway listens on",
+		set:   setString(func(c *Config) *string { return &c.Server.HTTPAddr }),
+	},
+	{
+		flag:  "shutdown-timeout",
+		usage: "time allowed to drain in-flight requests on shutdown",
+		set:   setDuration(func(c *Config) *time.Duration { return &c.Server.ShutdownTimeout }),
+	},
+	{
+		flag:  "watch-heartbeat",
+		usage: "interval of the heartbeats sent on the idle exchange rates watches",
+		set:   setDuration(func(c *Config) *time.Duration { return &c.Server.WatchHeartbeat }),
+	},
+	{
+		flag:  "rates-ttl",
+		usage: "validity of a cached exchange rate",
+		set:   setDuration(func(c *Config) *time.Duration { return &c.Cache.RatesTTL }),
+	},
+	{
+		flag:  "currencies-ttl",
+		usage: "validity of the cached list of currencies of a provider",
+		set:   setDuration(func(c *Config) *time.Duration { return &c.Cache.CurrenciesTTL }),
+	},
+	{
+		flag:  "refresh-interval",
+		usage: "interval of the exchange rates refresher",
+		set:   setDuration(func(c *Config) *time.Duration { return &c.Jobs.RefreshInterval }),
+	},
+	{
+		flag:  "cleanup-interval",
+		usage: "interval of the cache cleaner",
+		set:   setDuration(func(c *Config) *time.Duration { return &c.Jobs.CleanupInterval }),
+	},
+	{
+		flag:  "health-check-interval",
+		usage: "interval at which the gRPC health status is updated",
+		set:   setDuration(func(c *Config) *time.Duration { return &c.Health.CheckInterval }),
+	},
+	{
+		flag:  "providers-failure-window",
+		usage: "how long all the providers can fail before the service is not ready",
+		set:   setDuration(func(c *Config) *time.Duration { return &c.Health.ProvidersFailureWindow }),
+	},
+	{
+		flag:  "refresher-stall-timeout",
+		usage: "how long a refresh can overrun the refresh interval before the service is not live",
+		set:   setDuration(func(c *Config) *time.Duration { return &c.Health.RefresherStallTimeout }),
+	},
+	{
+		flag:  "default-provider",
+		usage: "exchange rates provider used when a request does not specify one",
+		set: func(c *Config, v string) error {
+			c.Exchange.DefaultProvider = exchange.ProviderType(v)
+			return nil
+		},
+	},
+	{
+		flag:  "default-rounding-mode",
+		usage: "rounding mode used when a request does not specify one: half-even, half-up, floor or ceiling",
+		set: func(c *Config, v string) error {
+			return c.Conversion.DefaultRoundingMode.UnmarshalText([]byte(v))
+		},
+	},
+	{
+		flag:  "providers",
+		usage: "comma separated exchange rates providers refreshed in the background",
+		set: func(c *Config, v string) error {
+			c.Exchange.Providers = nil
+			for _, p := range strings.Split(v, ",") {
+				if p = strings.TrimSpace(p); p != "" {
+					c.Exchange.Providers = append(c.Exchange.Providers, exchange.ProviderType(p))
+				}
+			}
+			return nil
+		},
+	},
+	{
+		flag:  "max-batch-size",
+		usage: "maximum number of conversions of a batch",
+		set:   setInt(func(c *Config) *int { return &c.Conversion.MaxBatchSize }),
+	},
+	{
+		flag:  "max-stream-in-flight",
+		usage: "maximum number of conversions of a stream converted at once",
+		set:   setInt(func(c *Config) *int { return &c.Conversion.MaxStreamInFlight }),
+	},
+	{
+		flag:  "compare-timeout",
+		usage: "time a comparison waits for the rates of a provider which are not cached",
+		set:   setDuration(func(c *Config) *time.Duration { return &c.Conversion.CompareTimeout }),
+	},
+	{
+		flag:  "quote-ttl",
+		usage: "validity of a quote, the time its rate is locked for",
+		set:   setDuration(func(c *Config) *time.Duration { return &c.Conversion.QuoteTTL }),
+	},
+	{
+		flag:  "history-dir",
+		usage: "directory the rates of every refresh are persisted in",
+		set:   setString(func(c *Config) *string { return &c.History.Dir }),
+	},
+	{
+		flag:  "history-retention",
+		usage: "how long the rates of every refresh are kept",
+		set:   setDuration(func(c *Config) *time.Duration { return &c.History.Retention }),
+	},
+	{
+		flag:  "pivots",
+		usage: "comma separated pivot currencies through which the pairs are triangulated",
+		set: func(c *Config, v string) error {
+			c.Conversion.Pivots = nil
+			for _, code := range strings.Split(v, ",") {
+				if code = strings.TrimSpace(code); code != "" {
+					c.Conversion.Pivots = append(c.Conversion.Pivots, strings.ToUpper(code))
+				}
+			}
+			return nil
+		},
+	},
+}
+
+func setString(field func(*Config) *string) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		*field(c) = v
+		return nil
+	}
+}
+
+func setInt(field func(*Config) *int) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return err
+		}
+		*field(c) = i
+		return nil
+	}
+}
+
+func setDuration(field func(*Config) *time.Duration) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return err
+		}
+		*field(c) = d
+		return nil
+	}
+}
+
+// Loader loads the configuration from its layers, each one overriding the previous:
+// defaults, the YAML file, the environment variables and the command line flags.
+type Loader struct {
+	// path of the YAML file. Empty means no file.
+	path string
+
+	// flags holds the values of the command line flags which were explicitly set.
+	flags map[string]string
+}
+
+// NewLoader parses the command line arguments and returns the Loader for them.
+// The YAML file is taken from the -config flag or the CONVERTER_CONFIG environment variable.
+func NewLoader(args []string) (*Loader, error) {
+	fs := flag.NewFlagSet("currency-converter", flag.ContinueOnError)
+
+	path := fs.String("config", os.Getenv(EnvPrefix+"CONFIG"), "path of the YAML configuration file")
+
+	values := map[string]*string{}
+	for _, s := range settings {
+		values[s.flag] = fs.String(s.flag, "", fmt.Sprintf("%s (env %s)", s.usage, s.EnvName()))
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	loader := &Loader{path: *path, flags: map[string]string{}}
+	fs.Visit(func(f *flag.Flag) {
+		if v, present := values[f.Name]; present {
+			loader.flags[f.Name] = *v
+		}
+	})
+
+	return loader, nil
+}
+
+// Load reads all the layers and returns the validated configuration.
+// It can be called again to re-read the configuration.
+func (loader *Loader) Load() (*Config, error) {
+	cfg := Default()
+
+	if loader.path != "" {
+		content, err := os.ReadFile(loader.path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the config file: %w", err)
+		}
+
+		if err = yaml.UnmarshalStrict(content, cfg); err != nil {
+			return nil, fmt.Errorf("failed to parse the config file [%s]: %w", loader.path, err)
+		}
+	}
+
+	for _, s := range settings {
+		if v, present := os.LookupEnv(s.EnvName()); present {
+			if err := s.set(cfg, v); err != nil {
+				return nil, fmt.Errorf("invalid value for %s: %w", s.EnvName(), err)
+			}
+		}
+	}
+
+	for _, s := range settings {
+		if v, present := loader.flags[s.flag]; present {
+			if err := s.set(cfg, v); err != nil {
+				return nil, fmt.Errorf("invalid value for -%s: %w", s.flag, err)
+			}
+		}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// Validate returns an error describing the first invalid value of the configuration.
+func (cfg *Config) Validate() error {
+	if cfg.Server.GRPCAddr == "" {
+		return fmt.Errorf("server.grpcAddr must be set")
+	}
+
+	if cfg.Server.HTTPAddr == "" {
+		return fmt.Errorf("server.httpAddr must be set")
+	}
+
+	if cfg.History.Dir == "" {
+		return fmt.Errorf("history.dir must be set")
+	}
+
+	durations := []struct {
+		name  string
+		value time.Duration
+	}{
+		{"server.shutdownTimeout", cfg.Server.ShutdownTimeout},
+		{"server.watchHeartbeat", cfg.Server.WatchHeartbeat},
+		{"cache.ratesTTL", cfg.Cache.RatesTTL},
+		{"cache.currenciesTTL", cfg.Cache.CurrenciesTTL},
+		{"jobs.refreshInterval", cfg.Jobs.RefreshInterval},
+		{"jobs.cleanupInterval", cfg.Jobs.CleanupInterval},
+		{"health.checkInterval", cfg.Health.CheckInterval},
+		{"health.providersFailureWindow", cfg.Health.ProvidersFailureWindow},
+		{"health.refresherStallTimeout", cfg.Health.RefresherStallTimeout},
+		{"history.retention", cfg.History.Retention},
+		{"conversion.compareTimeout", cfg.Conversion.CompareTimeout},
+		{"conversion.quoteTTL", cfg.Conversion.QuoteTTL},
+	}
+	for _, d := range durations {
+		if d.value <= 0 {
+			return fmt.Errorf("%s must be positive, got [%s]", d.name, d.value)
+		}
+	}
+
+	if !exchange.IsSupportedProvider(cfg.Exchange.DefaultProvider) {
+		return fmt.Errorf("exchange.defaultProvider [%s] is not supported", cfg.Exchange.DefaultProvider)
+	}
+
+	if len(cfg.Exchange.Providers) == 0 {
+		return fmt.Errorf("exchange.providers must not be empty")
+	}
+
+	for _, p := range cfg.Exchange.Providers {
+		if !exchange.IsSupportedProvider(p) {
+			return fmt.Errorf("exchange.providers: [%s] is not supported", p)
+		}
+	}
+
+	for _, code := range cfg.Conversion.Pivots {
+		if !currency.IsKnown(code) {
+			return fmt.Errorf("conversion.pivots: [%s] is not a known currency", code)
+		}
+	}
+
+	if cfg.Conversion.MaxBatchSize <= 0 {
+		return fmt.Errorf("conversion.maxBatchSize must be positive, got [%d]", cfg.Conversion.MaxBatchSize)
+	}
+
+	if cfg.Conversion.MaxStreamInFlight <= 0 {
+		return fmt.Errorf("conversion.maxStreamInFlight must be positive, got [%d]", cfg.Conversion.MaxStreamInFlight)
+	}
+
+	for i, rule := range cfg.Pricing.Rules {
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("pricing.rules[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
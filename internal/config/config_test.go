@@ -0,0 +1,241 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"currency-converter/internal/exchange"
+)
+
+// writeConfig writes the YAML configuration to a file and returns its path.
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func load(t *testing.T, args []string) (*Config, error) {
+	t.Helper()
+
+	loader, err := NewLoader(args)
+	if err != nil {
+		t.Fatalf("failed to parse the arguments %v: %v", args, err)
+	}
+
+	return loader.Load()
+}
+
+func TestLoadLayersPrecedence(t *testing.T) {
+	tests := []struct {
+		name  string
+		yaml  string
+		env   map[string]string
+		flags []string
+		check func(t *testing.T, cfg *Config)
+	}{
+		{
+			name: "defaults",
+			check: func(t *testing.T, cfg *Config) {
+				if !reflect.DeepEqual(cfg, Default()) {
+					t.Errorf("got the changes %v, want the defaults", Diff(Default(), cfg))
+				}
+			},
+		},
+		{
+			name: "the file overrides the defaults",
+			yaml: "jobs:\n  refreshInterval: 1m\n",
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.Jobs.RefreshInterval != time.Minute {
+					t.Errorf("got the refresh interval [%s], want [1m0s]", cfg.Jobs.RefreshInterval)
+				}
+				if cfg.Jobs.CleanupInterval != Default().Jobs.CleanupInterval {
+					t.Errorf("got the cleanup interval [%s], want the default", cfg.Jobs.CleanupInterval)
+				}
+			},
+		},
+		{
+			name: "the environment overrides the file",
+			yaml: "jobs:\n  refreshInterval: 1m\n",
+			env:  map[string]string{"CONVERTER_REFRESH_INTERVAL": "2m"},
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.Jobs.RefreshInterval != 2*time.Minute {
+					t.Errorf("got the refresh interval [%s], want [2m0s]", cfg.Jobs.RefreshInterval)
+				}
+			},
+		},
+		{
+			name:  "the flags override the environment",
+			yaml:  "jobs:\n  refreshInterval: 1m\n",
+			env:   map[string]string{"CONVERTER_REFRESH_INTERVAL": "2m"},
+			flags: []string{"-refresh-interval", "3m"},
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.Jobs.RefreshInterval != 3*time.Minute {
+					t.Errorf("got the refresh interval [%s], want [3m0s]", cfg.Jobs.RefreshInterval)
+				}
+			},
+		},
+		{
+			name:  "the lists replace the ones of the lower layers",
+			yaml:  "exchange:\n  providers: [fixer, currencylayer]\n",
+			env:   map[string]string{"CONVERTER_PIVOTS": "usd, eur"},
+			flags: []string{"-providers", "fixer"},
+			check: func(t *testing.T, cfg *Config) {
+				if want := []exchange.ProviderType{exchange.Fixer}; !reflect.DeepEqual(cfg.Exchange.Providers, want) {
+					t.Errorf("got the providers %v, want %v", cfg.Exchange.Providers, want)
+				}
+				if want := []string{"USD", "EUR"}; !reflect.DeepEqual(cfg.Conversion.Pivots, want) {
+					t.Errorf("got the pivots %v, want %v", cfg.Conversion.Pivots, want)
+				}
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for name, value := range test.env {
+				t.Setenv(name, value)
+			}
+
+			args := test.flags
+			if test.yaml != "" {
+				args = append([]string{"-config", writeConfig(t, test.yaml)}, args...)
+			}
+
+			cfg, err := load(t, args)
+			if err != nil {
+				t.Fatalf("got the error [%v], want the configuration", err)
+			}
+
+			test.check(t, cfg)
+		})
+	}
+}
+
+func TestLoadRejectsInvalidValues(t *testing.T) {
+	tests := []struct {
+		name  string
+		yaml  string
+		env   map[string]string
+		flags []string
+		want  string
+	}{
+		{
+			name: "unknown field of the file",
+			yaml: "jobs:\n  refreshIntervall: 1m\n",
+			want: "refreshIntervall",
+		},
+		{
+			name: "invalid duration of the environment",
+			env:  map[string]string{"CONVERTER_QUOTE_TTL": "soon"},
+			want: "CONVERTER_QUOTE_TTL",
+		},
+		{
+			name:  "invalid number of the flags",
+			flags: []string{"-max-batch-size", "many"},
+			want:  "-max-batch-size",
+		},
+		{
+			name:  "invalid value of a lower layer overridden by the flags",
+			yaml:  "jobs:\n  refreshInterval: 1m\n",
+			flags: []string{"-refresh-interval", "0s"},
+			want:  "jobs.refreshInterval",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for name, value := range test.env {
+				t.Setenv(name, value)
+			}
+
+			args := test.flags
+			if test.yaml != "" {
+				args = append([]string{"-config", writeConfig(t, test.yaml)}, args...)
+			}
+
+			_, err := load(t, args)
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("got the error [%v], want an error about [%s]", err, test.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(cfg *Config)
+		want   string
+	}{
+		{
+			name:   "empty gRPC address",
+			modify: func(cfg *Config) { cfg.Server.GRPCAddr = "" },
+			want:   "server.grpcAddr",
+		},
+		{
+			name:   "empty history directory",
+			modify: func(cfg *Config) { cfg.History.Dir = "" },
+			want:   "history.dir",
+		},
+		{
+			name:   "negative duration",
+			modify: func(cfg *Config) { cfg.Cache.RatesTTL = -time.Minute },
+			want:   "cache.ratesTTL",
+		},
+		{
+			name:   "unsupported default provider",
+			modify: func(cfg *Config) { cfg.Exchange.DefaultProvider = "bogus" },
+			want:   "exchange.defaultProvider",
+		},
+		{
+			name:   "no providers",
+			modify: func(cfg *Config) { cfg.Exchange.Providers = nil },
+			want:   "exchange.providers",
+		},
+		{
+			name:   "unsupported provider",
+			modify: func(cfg *Config) { cfg.Exchange.Providers = []exchange.ProviderType{exchange.Fixer, "bogus"} },
+			want:   "[bogus]",
+		},
+		{
+			name:   "unknown pivot",
+			modify: func(cfg *Config) { cfg.Conversion.Pivots = []string{"USD", "ABC"} },
+			want:   "conversion.pivots",
+		},
+		{
+			name:   "zero batch size",
+			modify: func(cfg *Config) { cfg.Conversion.MaxBatchSize = 0 },
+			want:   "conversion.maxBatchSize",
+		},
+		{
+			name:   "zero stream in flight",
+			modify: func(cfg *Config) { cfg.Conversion.MaxStreamInFlight = 0 },
+			want:   "conversion.maxStreamInFlight",
+		},
+	}
+
+	if err := Default().Validate(); err != nil {
+		t.Fatalf("got the error [%v] for the defaults, want none", err)
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := Default()
+			test.modify(cfg)
+
+			err := cfg.Validate()
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("got the error [%v], want an error about [%s]", err, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Holder holds the current configuration, which can be swapped at runtime.
+// Readers always get a complete and validated configuration, either the old or the new one.
+type Holder struct {
+	current atomic.Value
+
+	mu          sync.Mutex
+	subscribers map[chan struct{}]struct{}
+}
+
+// NewHolder is the constructor for Holder with the initial configuration.
+func NewHolder(cfg *Config) *Holder {
+	holder := &Holder{
+		subscribers: map[chan struct{}]struct{}{},
+	}
+	holder.current.Store(cfg)
+
+	return holder
+}
+
+// Get returns the current configuration. It must not be modified.
+func (holder *Holder) Get() *Config {
+	return holder.current.Load().(*Config)
+}
+
+// Subscribe returns a channel receiving a notification every time the configuration is swapped,
+// and the function to stop the notifications.
+// Notifications are coalesced, the subscriber has to call Get to read the latest configuration.
+func (holder *Holder) Subscribe() (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	holder.mu.Lock()
+	holder.subscribers[ch] = struct{}{}
+	holder.mu.Unlock()
+
+	return ch, func() {
+		holder.mu.Lock()
+		delete(holder.subscribers, ch)
+		holder.mu.Unlock()
+	}
+}
+
+// Reload loads the configuration again and swaps it in, returning the list of the changed values.
+// An invalid configuration is rejected and the current one is kept.
+func (holder *Holder) Reload(loader *Loader) ([]string, error) {
+	cfg, err := loader.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	holder.mu.Lock()
+	defer holder.mu.Unlock()
+
+	changes := Diff(holder.Get(), cfg)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	holder.current.Store(cfg)
+
+	for ch := range holder.subscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+			// a notification is already pending
+		}
+	}
+
+	return changes, nil
+}
+
+// Diff returns the values which differ between the two configurations, e.g. "jobs.refreshInterval: 5m0s -> 1m0s".
+func Diff(previous, next *Config) []string {
+	var changes []string
+	diff(reflect.ValueOf(previous).Elem(), reflect.ValueOf(next).Elem(), "", &changes)
+
+	return changes
+}
+
+func diff(previous, next reflect.Value, prefix string, changes *[]string) {
+	if previous.Kind() == reflect.Struct {
+		for i := 0; i < previous.NumField(); i++ {
+			name := strings.Split(previous.Type().Field(i).Tag.Get("yaml"), ",")[0]
+			if prefix != "" {
+				name = prefix + "." + name
+			}
+			diff(previous.Field(i), next.Field(i), name, changes)
+		}
+
+		return
+	}
+
+	if previous.Kind() == reflect.Slice && previous.Len() == 0 && next.Len() == 0 {
+		// a nil and an empty list are the same setting.
+		return
+	}
+
+	if !reflect.DeepEqual(previous.Interface(), next.Interface()) {
+		*changes = append(*changes, fmt.Sprintf("%s: %v -> %v", prefix, previous.Interface(), next.Interface()))
+	}
+}
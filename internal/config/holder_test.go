@@ -0,0 +1,91 @@
+package config
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestReload(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr bool
+		want    time.Duration
+	}{
+		{
+			name: "valid configuration",
+			yaml: "jobs:\n  refreshInterval: 2m\n",
+			want: 2 * time.Minute,
+		},
+		{
+			name:    "invalid value",
+			yaml:    "jobs:\n  refreshInterval: -2m\n",
+			wantErr: true,
+			want:    time.Minute,
+		},
+		{
+			name:    "unknown field",
+			yaml:    "jobs:\n  refreshIntervall: 2m\n",
+			wantErr: true,
+			want:    time.Minute,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := writeConfig(t, "jobs:\n  refreshInterval: 1m\n")
+
+			loader, err := NewLoader([]string{"-config", path})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cfg, err := loader.Load()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			holder := NewHolder(cfg)
+			notifications, unsubscribe := holder.Subscribe()
+			defer unsubscribe()
+
+			if err = os.WriteFile(path, []byte(test.yaml), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			changes, err := holder.Reload(loader)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got the error [%v], want an error: %t", err, test.wantErr)
+			}
+
+			if got := holder.Get().Jobs.RefreshInterval; got != test.want {
+				t.Errorf("got the refresh interval [%s], want [%s]", got, test.want)
+			}
+
+			notified := false
+			select {
+			case <-notifications:
+				notified = true
+			default:
+			}
+
+			if test.wantErr {
+				if holder.Get() != cfg {
+					t.Error("got a new configuration, want the current one kept")
+				}
+				if notified {
+					t.Error("got a notification, want none for a rejected configuration")
+				}
+				return
+			}
+
+			if !notified {
+				t.Error("got no notification, want one for the swapped configuration")
+			}
+			if want := "jobs.refreshInterval: 1m0s -> 2m0s"; len(changes) != 1 || changes[0] != want {
+				t.Errorf("got the changes %v, want [%s]", changes, want)
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package errors
 
 import (
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -11,8 +14,61 @@ var (
 	UpstreamExchangeRateServerError = status.Error(codes.Internal, "failed to get the exchange rates from upstream")
 	InternalCacheError              = status.Error(codes.Internal, "failed to complete a transaction with cache")
 	UnImplementedError              = status.Error(codes.Unimplemented, "method not implemented")
+	AmountOverflowError             = status.Error(codes.OutOfRange, "converted amount is out of range")
+	ShuttingDownError               = status.Error(codes.Unavailable, "the server is shutting down")
 )
 
+// UnknownCurrencyError returns the InvalidArgument error for a currency code which is not in the currency registry.
+func UnknownCurrencyError(code string) error {
+	return status.Errorf(codes.InvalidArgument, "unknown currency code [%s]", code)
+}
+
+// InvalidExchangeRateError returns the Internal error for an exchange rate which is not positive, and so cannot be used.
+func InvalidExchangeRateError(code string) error {
+	return status.Errorf(codes.Internal, "invalid exchange rate for the currency code [%s]", code)
+}
+
+// NoConversionPathError returns the NotFound error for a pair which cannot be converted with the cached rates,
+// even through the pivot currencies.
+func NoConversionPathError(from, to string) error {
+	return status.Errorf(codes.NotFound, "no exchange rate from [%s] to [%s]", from, to)
+}
+
+// UnsupportedProviderError returns the InvalidArgument error for an exchange provider which is not supported.
+func UnsupportedProviderError(exchangeProvider string) error {
+	return status.Errorf(codes.InvalidArgument, "unsupported exchange provider [%s]", exchangeProvider)
+}
+
+// QuoteNotFoundError returns the NotFound error for a quote which is unknown, or evicted once expired.
+func QuoteNotFoundError(id string) error {
+	return status.Errorf(codes.NotFound, "quote [%s] not found", id)
+}
+
+// QuoteExpiredError returns the FailedPrecondition error for a quote used after its expiry.
+func QuoteExpiredError(id string) error {
+	return status.Errorf(codes.FailedPrecondition, "quote [%s] expired", id)
+}
+
+// QuoteUsedError returns the FailedPrecondition error for a quote which was already used.
+func QuoteUsedError(id string) error {
+	return status.Errorf(codes.FailedPrecondition, "quote [%s] already used", id)
+}
+
+// FieldViolationError returns the InvalidArgument error with a google.rpc.BadRequest detail pointing at the field,
+// e.g. "from.value".
+func FieldViolationError(field, description string) error {
+	st := status.New(codes.InvalidArgument, fmt.Sprintf("invalid %s: %s", field, description))
+
+	detailed, err := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: description}},
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
 // IsNotFound returns true if the error is NotFound error.
 func IsNotFound(err error) bool {
 	return codes.NotFound == status.Code(err)
@@ -7,7 +7,10 @@ import (
 	"currency-converter/internal/exchange"
 )
 
-var _ exchange.Provider = (*provider)(nil)
+var (
+	_ exchange.Provider   = (*provider)(nil)
+	_ exchange.BaseQuoter = (*provider)(nil)
+)
 
 type provider struct {
 	//nolint:structcheck,unused
@@ -15,13 +18,17 @@ type provider struct {
 }
 
 func New() exchange.Provider {
-	return nil
+	return &provider{}
 }
 
 func (p *provider) LiveRates() (map[string]float32, error) {
 	return nil, status.Error(codes.Unimplemented, "function not implemented for the provider")
 }
 
+func (p *provider) LiveRatesAgainst(base string) (map[string]float32, error) {
+	return nil, status.Error(codes.Unimplemented, "function not implemented for the provider")
+}
+
 func (p *provider) Currencies() ([]string, error) {
 	return nil, status.Error(codes.Unimplemented, "function not implemented for the provider")
 }
@@ -1,13 +1,18 @@
 package fixer
 
 import (
+	"time"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"currency-converter/internal/exchange"
 )
 
-var _ exchange.Provider = (*provider)(nil)
+var (
+	_ exchange.Provider         = (*provider)(nil)
+	_ exchange.HistoricalQuoter = (*provider)(nil)
+)
 
 type provider struct {
 	//nolint:structcheck,unused
@@ -15,13 +20,17 @@ type provider struct {
 }
 
 func New() exchange.Provider {
-	return nil
+	return &provider{}
 }
 
 func (p *provider) LiveRates() (map[string]float32, error) {
 	return nil, status.Error(codes.Unimplemented, "function not implemented for the provider")
 }
 
+func (p *provider) HistoricalRates(date time.Time) (map[string]float32, error) {
+	return nil, status.Error(codes.Unimplemented, "function not implemented for the provider")
+}
+
 func (p *provider) Currencies() ([]string, error) {
 	return nil, status.Error(codes.Unimplemented, "function not implemented for the provider")
 }
@@ -15,7 +15,7 @@ type provider struct {
 }
 
 func New() exchange.Provider {
-	return nil
+	return &provider{}
 }
 
 func (p *provider) LiveRates() (map[string]float32, error) {
@@ -1,5 +1,9 @@
 package exchange
 
+import (
+	"time"
+)
+
 // Provider represents different exchange providers.
 type Provider interface {
 	// LiveRates fetch the live exchange rates for all the supported currencies.
@@ -9,6 +13,23 @@ type Provider interface {
 	Currencies() ([]string, error)
 }
 
+// BaseQuoter is implemented by the providers which can also quote their live rates against other base currencies
+// than BaseCurrency, e.g. against BTC for the crypto assets.
+type BaseQuoter interface {
+	// LiveRatesAgainst fetches the live exchange rates for all the supported currencies against the base currency.
+	LiveRatesAgainst(base string) (map[string]float32, error)
+}
+
+// HistoricalQuoter is implemented by the providers which serve the exchange rates of past dates.
+type HistoricalQuoter interface {
+	// HistoricalRates fetches the exchange rates against BaseCurrency at the end of the date, for all the
+	// supported currencies.
+	HistoricalRates(date time.Time) (map[string]float32, error)
+}
+
+// BaseCurrency is the currency against which all the exchange rates are quoted by the providers.
+const BaseCurrency = "USD"
+
 // ProviderType represents the type fo the exchange rates provider supported.
 type ProviderType string
 
@@ -37,3 +58,14 @@ func GetSupportedProviders() []ProviderType {
 		Yahoo,
 	}
 }
+
+// IsSupportedProvider returns true if the provider is one of the supported exchange rate providers.
+func IsSupportedProvider(providerType ProviderType) bool {
+	for _, supported := range GetSupportedProviders() {
+		if supported == providerType {
+			return true
+		}
+	}
+
+	return false
+}
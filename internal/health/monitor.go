@@ -0,0 +1,135 @@
+package health
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"currency-converter/internal/cache"
+	"currency-converter/internal/config"
+	"currency-converter/internal/exchange"
+)
+
+var _ cache.ProviderReporter = (*Monitor)(nil)
+
+// Monitor tracks the warmth of the cache, the reachability of the exchange providers
+// and the progress of the exchange rates refresher, to answer the readiness and liveness checks.
+type Monitor struct {
+	settings *config.Holder
+
+	mu *sync.RWMutex
+
+	// warm is set once the exchange rates have been refreshed successfully.
+	warm bool
+
+	// shuttingDown is set once the service started draining.
+	shuttingDown bool
+
+	// failingSince holds, for every failing provider, the time of its first failure after its last success.
+	failingSince map[exchange.ProviderType]time.Time
+
+	// lastRefresh is the time at which the refresher last completed a refresh, or was started.
+	lastRefresh time.Time
+}
+
+// NewMonitor is the constructor for Monitor, with the thresholds read from settings.
+func NewMonitor(settings *config.Holder) *Monitor {
+	return &Monitor{
+		settings:     settings,
+		mu:           &sync.RWMutex{},
+		failingSince: map[exchange.ProviderType]time.Time{},
+		lastRefresh:  time.Now(),
+	}
+}
+
+// ReportSuccess marks the provider as reachable.
+func (monitor *Monitor) ReportSuccess(exchangeProvider exchange.ProviderType) {
+	monitor.mu.Lock()
+	defer monitor.mu.Unlock()
+
+	delete(monitor.failingSince, exchangeProvider)
+}
+
+// ReportFailure marks the provider as failing, since now if it was reachable until now.
+func (monitor *Monitor) ReportFailure(exchangeProvider exchange.ProviderType, _ error) {
+	monitor.mu.Lock()
+	defer monitor.mu.Unlock()
+
+	if _, failing := monitor.failingSince[exchangeProvider]; !failing {
+		monitor.failingSince[exchangeProvider] = time.Now()
+	}
+}
+
+// FailingSince returns the time of the first failure of the provider after its last success,
+// and false when the provider is reachable.
+func (monitor *Monitor) FailingSince(exchangeProvider exchange.ProviderType) (time.Time, bool) {
+	monitor.mu.RLock()
+	defer monitor.mu.RUnlock()
+
+	since, failing := monitor.failingSince[exchangeProvider]
+
+	return since, failing
+}
+
+// RefreshCompleted is called by the exchange rates refresher after every refresh, with its outcome.
+func (monitor *Monitor) RefreshCompleted(err error) {
+	monitor.mu.Lock()
+	defer monitor.mu.Unlock()
+
+	monitor.lastRefresh = time.Now()
+	if err == nil {
+		monitor.warm = true
+	}
+}
+
+// ShuttingDown marks the service as not ready, so that the load balancer stops sending new requests.
+func (monitor *Monitor) ShuttingDown() {
+	monitor.mu.Lock()
+	defer monitor.mu.Unlock()
+
+	monitor.shuttingDown = true
+}
+
+// Ready returns an error describing why the service should not receive requests.
+func (monitor *Monitor) Ready() error {
+	if err := monitor.Live(); err != nil {
+		return err
+	}
+
+	monitor.mu.RLock()
+	defer monitor.mu.RUnlock()
+
+	if monitor.shuttingDown {
+		return fmt.Errorf("shutting down")
+	}
+
+	if !monitor.warm {
+		return fmt.Errorf("exchange rates not refreshed yet")
+	}
+
+	cfg := monitor.settings.Get()
+
+	for _, provider := range cfg.Exchange.Providers {
+		since, failing := monitor.failingSince[provider]
+		if !failing || time.Since(since) < cfg.Health.ProvidersFailureWindow {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("all the exchange providers are failing for more than [%s]", cfg.Health.ProvidersFailureWindow)
+}
+
+// Live returns an error if the exchange rates refresher is wedged.
+func (monitor *Monitor) Live() error {
+	monitor.mu.RLock()
+	defer monitor.mu.RUnlock()
+
+	cfg := monitor.settings.Get()
+
+	deadline := monitor.lastRefresh.Add(cfg.Jobs.RefreshInterval + cfg.Health.RefresherStallTimeout)
+	if time.Now().After(deadline) {
+		return fmt.Errorf("exchange rates refresher has not completed a refresh since [%s]", monitor.lastRefresh.Format(time.RFC3339))
+	}
+
+	return nil
+}
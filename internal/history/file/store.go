@@ -0,0 +1,332 @@
+package file
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"currency-converter/internal/cache"
+	"currency-converter/internal/config"
+	apierrs "currency-converter/internal/errors"
+	"currency-converter/internal/exchange"
+	"currency-converter/internal/history"
+)
+
+var _ history.Store = (*fileStore)(nil)
+
+// record is a rates table as written in the history, one JSON object per line.
+type record struct {
+	Base      string             `json:"base"`
+	FetchedAt time.Time          `json:"fetchedAt"`
+	Values    map[string]float32 `json:"values"`
+}
+
+// fileStore is the history store keeping the rates tables of a provider in a file per UTC day,
+// as <dir>/<provider>/<YYYY-MM-DD>.jsonl.
+type fileStore struct {
+	mu *sync.RWMutex
+
+	// settings holds the directory and the retention of the history, read when the history is written.
+	settings *config.Holder
+
+	// prunedOn is the day the expired files were last removed.
+	prunedOn time.Time
+}
+
+// NewStore is a constructor for the file history store.
+// The directory and the retention are read from the current configuration of settings.
+func NewStore(settings *config.Holder) history.Store {
+	return &fileStore{
+		mu:       &sync.RWMutex{},
+		settings: settings,
+	}
+}
+
+// Record appends the rates table to the file of the day it was fetched at.
+// The files older than the retention are removed once a day.
+func (store *fileStore) Record(exchangeProvider exchange.ProviderType, rates *cache.Rates) error {
+	line, err := json.Marshal(record{Base: rates.Base, FetchedAt: rates.FetchedAt.UTC(), Values: rates.Values})
+	if err != nil {
+		return err
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	dir, err := store.providerDir(exchangeProvider)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(dir, 0o750); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(dayFile(dir, rates.FetchedAt), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o640)
+	if err != nil {
+		return err
+	}
+
+	if _, err = f.Write(append(line, '\n')); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	if today := history.StartOfDay(time.Now()); store.prunedOn.Before(today) {
+		store.prunedOn = today
+		store.prune()
+	}
+
+	return nil
+}
+
+// Day returns the last rates table recorded against every base currency during the UTC day of the date,
+// in the order the base currencies were first recorded that day.
+func (store *fileStore) Day(exchangeProvider exchange.ProviderType, date time.Time) ([]*cache.Rates, error) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	dir, err := store.providerDir(exchangeProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := readDay(dir, date)
+	if err != nil {
+		return nil, err
+	}
+
+	last := map[string]*cache.Rates{}
+	var bases []string
+
+	for _, r := range records {
+		if _, present := last[r.Base]; !present {
+			bases = append(bases, r.Base)
+		}
+
+		last[r.Base] = &cache.Rates{Base: r.Base, Values: r.Values, FetchedAt: r.FetchedAt}
+	}
+
+	tables := make([]*cache.Rates, 0, len(bases))
+	for _, base := range bases {
+		tables = append(tables, last[base])
+	}
+
+	return tables, nil
+}
+
+// Range returns the rates tables recorded from start, inclusive, to end, exclusive, ordered by fetch time.
+// At most limit tables are returned, the first ones: the days after the limit is reached are not read.
+// The days without record are skipped.
+func (store *fileStore) Range(
+	exchangeProvider exchange.ProviderType,
+	start, end time.Time,
+	limit int) ([]*cache.Rates, error) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	dir, err := store.providerDir(exchangeProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	var tables []*cache.Rates
+	for day := history.StartOfDay(start); day.Before(end) && len(tables) < limit; day = day.Add(24 * time.Hour) {
+		records, err := readDay(dir, day)
+		if apierrs.IsNotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range records {
+			if !r.FetchedAt.Before(start) && r.FetchedAt.Before(end) {
+				tables = append(tables, &cache.Rates{Base: r.Base, Values: r.Values, FetchedAt: r.FetchedAt})
+			}
+		}
+	}
+
+	// the rates fetched from the historical endpoint of a provider can be recorded after the refreshes of the day.
+	sort.SliceStable(tables, func(i, j int) bool {
+		return tables[i].FetchedAt.Before(tables[j].FetchedAt)
+	})
+
+	if len(tables) > limit {
+		tables = tables[:limit]
+	}
+
+	return tables, nil
+}
+
+// Before returns the last rates table recorded against every base currency before the time, as of the last UTC day
+// with a record before it, in the order the base currencies were first recorded that day.
+// returns NotFound error if there is no record before.
+func (store *fileStore) Before(exchangeProvider exchange.ProviderType, before time.Time) ([]*cache.Rates, error) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	dir, err := store.providerDir(exchangeProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.jsonl"))
+	if err != nil {
+		return nil, err
+	}
+
+	var days []time.Time
+	for _, f := range files {
+		date, err := time.Parse(history.DateLayout, strings.TrimSuffix(filepath.Base(f), ".jsonl"))
+		// a day has only records fetched that day, none is before the time once the day starts after it.
+		if err == nil && date.Before(before) {
+			days = append(days, date)
+		}
+	}
+
+	// the latest day first, the earlier ones only if it has no record before the time.
+	sort.Slice(days, func(i, j int) bool {
+		return days[i].After(days[j])
+	})
+
+	for _, day := range days {
+		records, err := readDay(dir, day)
+		if apierrs.IsNotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		last := map[string]*cache.Rates{}
+		var bases []string
+
+		for _, r := range records {
+			if !r.FetchedAt.Before(before) {
+				continue
+			}
+
+			current, present := last[r.Base]
+			if !present {
+				bases = append(bases, r.Base)
+			}
+
+			// the rates fetched from the historical endpoint of a provider can be recorded out of order.
+			if !present || !r.FetchedAt.Before(current.FetchedAt) {
+				last[r.Base] = &cache.Rates{Base: r.Base, Values: r.Values, FetchedAt: r.FetchedAt}
+			}
+		}
+
+		if len(bases) == 0 {
+			continue
+		}
+
+		tables := make([]*cache.Rates, 0, len(bases))
+		for _, base := range bases {
+			tables = append(tables, last[base])
+		}
+
+		return tables, nil
+	}
+
+	return nil, apierrs.CacheKeyNotFoundError
+}
+
+// readDay returns the records of the file of the day, in the order they were written.
+// returns NotFound error if there is no record that day.
+func readDay(dir string, date time.Time) ([]record, error) {
+	f, err := os.Open(dayFile(dir, date))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, apierrs.CacheKeyNotFoundError
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var records []record
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	for scanner.Scan() {
+		var r record
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			// a line cut short by a crash, the other records are still valid.
+			logrus.WithError(err).Warnf("skipping an invalid record of [%s]", f.Name())
+			continue
+		}
+
+		records = append(records, r)
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read [%s]: %w", f.Name(), err)
+	}
+
+	if len(records) == 0 {
+		return nil, apierrs.CacheKeyNotFoundError
+	}
+
+	return records, nil
+}
+
+// prune removes the files of the days older than the retention, of all the providers.
+func (store *fileStore) prune() {
+	settings := store.settings.Get().History
+	oldest := history.StartOfDay(time.Now().Add(-settings.Retention))
+
+	days, err := filepath.Glob(filepath.Join(settings.Dir, "*", "*.jsonl"))
+	if err != nil {
+		logrus.WithError(err).Warn("failed to list the history files")
+		return
+	}
+
+	for _, day := range days {
+		date, err := time.Parse(history.DateLayout, strings.TrimSuffix(filepath.Base(day), ".jsonl"))
+		if err != nil || !date.Before(oldest) {
+			continue
+		}
+
+		if err = os.Remove(day); err != nil {
+			logrus.WithError(err).Warnf("failed to remove the expired history file [%s]", day)
+		}
+	}
+}
+
+// providerDir returns the directory of the files of the provider.
+// returns InvalidArgument error if the provider is not a single path element, as its directory would not be
+// directly under the history directory.
+func (store *fileStore) providerDir(exchangeProvider exchange.ProviderType) (string, error) {
+	root := filepath.Clean(store.settings.Get().History.Dir)
+
+	dir := filepath.Join(root, string(exchangeProvider))
+	if filepath.Dir(dir) != root || filepath.Base(dir) != string(exchangeProvider) {
+		return "", apierrs.UnsupportedProviderError(string(exchangeProvider))
+	}
+
+	return dir, nil
+}
+
+// dayFile returns the file of the UTC day of the date in the directory of a provider.
+func dayFile(dir string, date time.Time) string {
+	return filepath.Join(dir, date.UTC().Format(history.DateLayout)+".jsonl")
+}
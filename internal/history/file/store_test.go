@@ -0,0 +1,156 @@
+package file
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"currency-converter/internal/cache"
+	"currency-converter/internal/config"
+	"currency-converter/internal/exchange"
+)
+
+func TestProvidersOutsideTheHistoryAreRejected(t *testing.T) {
+	cfg := config.Default()
+	cfg.History.Dir = t.TempDir()
+
+	store := NewStore(config.NewHolder(cfg))
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, exProvider := range []exchange.ProviderType{"", ".", "..", "../evil", "a/b", "/etc"} {
+		rates := &cache.Rates{Base: "USD", Values: map[string]float32{"EUR": 0.9}, FetchedAt: date}
+		if err := store.Record(exProvider, rates); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Record [%s]: got the error [%v], want InvalidArgument", exProvider, err)
+		}
+
+		if _, err := store.Day(exProvider, date); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Day [%s]: got the error [%v], want InvalidArgument", exProvider, err)
+		}
+
+		if _, err := store.Range(exProvider, date, date.Add(time.Hour), 1); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Range [%s]: got the error [%v], want InvalidArgument", exProvider, err)
+		}
+	}
+}
+
+func TestBefore(t *testing.T) {
+	cfg := config.Default()
+	cfg.History.Dir = t.TempDir()
+
+	store := NewStore(config.NewHolder(cfg))
+	exProvider := cfg.Exchange.DefaultProvider
+
+	// the history of the last days is kept, an older one would be pruned once recorded.
+	day := time.Now().UTC().Truncate(24 * time.Hour).Add(-72 * time.Hour)
+
+	for _, rates := range []*cache.Rates{
+		{Base: "EUR", Values: map[string]float32{"GBP": 0.8}, FetchedAt: day.Add(time.Hour)},
+		{Base: "USD", Values: map[string]float32{"EUR": 0.9}, FetchedAt: day.Add(2 * time.Hour)},
+		{Base: "USD", Values: map[string]float32{"EUR": 0.7}, FetchedAt: day.Add(26 * time.Hour)},
+		{Base: "USD", Values: map[string]float32{"EUR": 0.5}, FetchedAt: day.Add(28 * time.Hour)},
+	} {
+		if err := store.Record(exProvider, rates); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		before time.Time
+		want   map[string]float32
+	}{
+		{name: "same day", before: day.Add(27 * time.Hour), want: map[string]float32{"USD": 0.7}},
+		{name: "day without record", before: day.Add(50 * time.Hour), want: map[string]float32{"USD": 0.5}},
+		{name: "earlier day", before: day.Add(25 * time.Hour), want: map[string]float32{"EUR": 0.8, "USD": 0.9}},
+		{name: "first record", before: day.Add(time.Hour)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tables, err := store.Before(exProvider, test.before)
+			if test.want == nil {
+				if status.Code(err) != codes.NotFound {
+					t.Fatalf("got the error [%v], want NotFound", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(tables) != len(test.want) {
+				t.Fatalf("got %d tables, want %d", len(tables), len(test.want))
+			}
+
+			for _, table := range tables {
+				var rate float32
+				for _, value := range table.Values {
+					rate = value
+				}
+
+				if want, present := test.want[table.Base]; !present || rate != want {
+					t.Errorf("got the rate %v against [%s], want %v", rate, table.Base, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRangeReturnsTheFirstTablesUpToTheLimit(t *testing.T) {
+	cfg := config.Default()
+	cfg.History.Dir = t.TempDir()
+
+	store := NewStore(config.NewHolder(cfg))
+	exProvider := cfg.Exchange.DefaultProvider
+
+	// the history of the last days is kept, an older one would be pruned once recorded.
+	day := time.Now().UTC().Truncate(24 * time.Hour).Add(-72 * time.Hour)
+
+	// the second day is recorded first, the tables are ordered by fetch time anyway.
+	for _, hours := range []int{30, 2, 1, 26} {
+		rates := &cache.Rates{
+			Base:      "USD",
+			Values:    map[string]float32{"EUR": float32(hours)},
+			FetchedAt: day.Add(time.Duration(hours) * time.Hour),
+		}
+		if err := store.Record(exProvider, rates); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		limit int
+		want  []float32
+	}{
+		{limit: 1, want: []float32{1}},
+		{limit: 3, want: []float32{1, 2, 26}},
+		{limit: 10, want: []float32{1, 2, 26, 30}},
+	}
+
+	for _, test := range tests {
+		tables, err := store.Range(exProvider, day, day.Add(48*time.Hour), test.limit)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got []float32
+		for _, table := range tables {
+			got = append(got, table.Values["EUR"])
+		}
+
+		if len(got) != len(test.want) {
+			t.Fatalf("limit %d: got %v, want %v", test.limit, got, test.want)
+		}
+
+		for i := range got {
+			if got[i] != test.want[i] {
+				t.Errorf("limit %d: got %v, want %v", test.limit, got, test.want)
+				break
+			}
+		}
+	}
+}
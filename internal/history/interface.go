@@ -0,0 +1,34 @@
+package history
+
+import (
+	"time"
+
+	"currency-converter/internal/cache"
+	"currency-converter/internal/exchange"
+)
+
+// DateLayout is the layout of the dates of the history, e.g. "2022-03-14".
+const DateLayout = "2006-01-02"
+
+// Store keeps the rates tables of every refresh of the exchange providers, by UTC day.
+type Store interface {
+	// Record keeps the rates table fetched from the exchange provider, under the UTC day it was fetched at.
+	cache.RatesRecorder
+
+	// Day returns the last rates table recorded against every base currency during the UTC day of the date.
+	// returns NotFound error if the provider has no rates recorded that day.
+	Day(exchangeProvider exchange.ProviderType, date time.Time) ([]*cache.Rates, error)
+
+	// Range returns the rates tables recorded from start, inclusive, to end, exclusive, ordered by fetch time.
+	// At most limit tables are returned, the first ones.
+	Range(exchangeProvider exchange.ProviderType, start, end time.Time, limit int) ([]*cache.Rates, error)
+
+	// Before returns the last rates table recorded against every base currency before the time, as of the last
+	// UTC day with a record before it. returns NotFound error if the provider has no rates recorded before.
+	Before(exchangeProvider exchange.ProviderType, before time.Time) ([]*cache.Rates, error)
+}
+
+// StartOfDay returns the start of the UTC day of the date.
+func StartOfDay(date time.Time) time.Time {
+	return date.UTC().Truncate(24 * time.Hour)
+}
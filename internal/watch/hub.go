@@ -0,0 +1,164 @@
+package watch
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"currency-converter/internal/cache"
+	"currency-converter/internal/exchange"
+)
+
+var _ cache.RatesRecorder = (*Hub)(nil)
+
+// Hub notifies its subscriptions of the exchange providers whose rates were set in the store.
+// It is a cache.RatesRecorder, recording the version of the last rates table of every provider.
+type Hub struct {
+	mu *sync.Mutex
+
+	// versions holds the version of the last rates table recorded, by exchange provider.
+	versions map[exchange.ProviderType]uint64
+
+	subscriptions map[*Subscription]struct{}
+
+	// epoch identifies the hub, i.e. the boot of the service, in the high bits of the tokens of its versions.
+	epoch uint64
+}
+
+// NewHub is a constructor for Hub.
+func NewHub() *Hub {
+	return &Hub{
+		mu:            &sync.Mutex{},
+		versions:      map[exchange.ProviderType]uint64{},
+		subscriptions: map[*Subscription]struct{}{},
+		epoch:         newEpoch(),
+	}
+}
+
+// epochShift is the position of the epoch in a token, the versions are in the bits below it.
+const epochShift = 32
+
+// newEpoch returns a random non-zero epoch, so that the tokens of two boots of the service never match.
+func newEpoch() uint64 {
+	var b [4]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		binary.BigEndian.PutUint32(b[:], uint32(time.Now().UnixNano()))
+	}
+
+	epoch := uint64(binary.BigEndian.Uint32(b[:]))
+	if epoch == 0 {
+		epoch = 1
+	}
+
+	return epoch
+}
+
+// Token returns the version as given to the clients, carrying the epoch of the hub.
+func (hub *Hub) Token(version uint64) uint64 {
+	return hub.epoch<<epochShift | version&(1<<epochShift-1)
+}
+
+// Version returns the version of the token, as long as the token was given by this hub.
+// The token of another boot of the service is not, even if its version is known.
+func (hub *Hub) Version(token uint64) (uint64, bool) {
+	if token>>epochShift != hub.epoch {
+		return 0, false
+	}
+
+	return token & (1<<epochShift - 1), true
+}
+
+// Record notifies all the subscriptions that the rates of the exchange provider changed.
+func (hub *Hub) Record(exchangeProvider exchange.ProviderType, rates *cache.Rates) error {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	if rates.Version > hub.versions[exchangeProvider] {
+		hub.versions[exchangeProvider] = rates.Version
+	}
+
+	for subscription := range hub.subscriptions {
+		subscription.notify(exchangeProvider, rates.Version)
+	}
+
+	return nil
+}
+
+// Versions returns the version of the last rates table recorded, by exchange provider.
+func (hub *Hub) Versions() map[exchange.ProviderType]uint64 {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	versions := make(map[exchange.ProviderType]uint64, len(hub.versions))
+	for exchangeProvider, version := range hub.versions {
+		versions[exchangeProvider] = version
+	}
+
+	return versions
+}
+
+// Subscribe returns a subscription notified of every rates table recorded from then on, until it is closed.
+func (hub *Hub) Subscribe() *Subscription {
+	subscription := &Subscription{
+		hub:     hub,
+		mu:      &sync.Mutex{},
+		pending: map[exchange.ProviderType]uint64{},
+		changes: make(chan struct{}, 1),
+	}
+
+	hub.mu.Lock()
+	hub.subscriptions[subscription] = struct{}{}
+	hub.mu.Unlock()
+
+	return subscription
+}
+
+// Subscription collects the exchange providers whose rates changed since they were last taken.
+// The changes of a provider are coalesced, so that a slow subscriber never blocks the store.
+type Subscription struct {
+	hub *Hub
+	mu  *sync.Mutex
+
+	// pending holds the version of the last rates table recorded, by exchange provider changed since the last Take.
+	pending map[exchange.ProviderType]uint64
+
+	changes chan struct{}
+}
+
+// Changes receives a value when providers changed since the last Take.
+func (subscription *Subscription) Changes() <-chan struct{} {
+	return subscription.changes
+}
+
+// Take returns the version of the last rates table recorded, by exchange provider changed since the last Take.
+func (subscription *Subscription) Take() map[exchange.ProviderType]uint64 {
+	subscription.mu.Lock()
+	defer subscription.mu.Unlock()
+
+	pending := subscription.pending
+	subscription.pending = map[exchange.ProviderType]uint64{}
+
+	return pending
+}
+
+// Close stops the notifications of the subscription.
+func (subscription *Subscription) Close() {
+	subscription.hub.mu.Lock()
+	delete(subscription.hub.subscriptions, subscription)
+	subscription.hub.mu.Unlock()
+}
+
+func (subscription *Subscription) notify(exchangeProvider exchange.ProviderType, version uint64) {
+	subscription.mu.Lock()
+	if version > subscription.pending[exchangeProvider] {
+		subscription.pending[exchangeProvider] = version
+	}
+	subscription.mu.Unlock()
+
+	select {
+	case subscription.changes <- struct{}{}:
+	default:
+		// a change is already signaled, it is taken with this one.
+	}
+}
@@ -0,0 +1,22 @@
+package watch
+
+import "testing"
+
+func TestTokensOfAnotherHubAreUnknown(t *testing.T) {
+	hub, restarted := NewHub(), NewHub()
+	for restarted.epoch == hub.epoch {
+		restarted = NewHub()
+	}
+
+	for _, version := range []uint64{0, 1, 42, 1<<epochShift - 1} {
+		token := hub.Token(version)
+
+		if got, current := hub.Version(token); !current || got != version {
+			t.Errorf("got the version [%d] (current: %t) of the token of [%d], want it back", got, current, version)
+		}
+
+		if _, current := restarted.Version(token); current {
+			t.Errorf("the token of [%d] is known by another hub", version)
+		}
+	}
+}
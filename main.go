@@ -2,34 +2,250 @@ package main
 
 import (
 	"context"
-	"log"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 	"time"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
+	pb "currency-converter/api/pb/v1alpha1/currencyconverter"
 	"currency-converter/internal/cache/inmemory"
+	"currency-converter/internal/config"
+	"currency-converter/internal/health"
+	"currency-converter/internal/history/file"
+	"currency-converter/internal/watch"
 	"currency-converter/pkg/backgroundjobs"
+	"currency-converter/pkg/server"
 )
 
 func main() {
+	loader, err := config.NewLoader(os.Args[1:])
+	if err != nil {
+		logrus.WithError(err).Fatal("failed to parse the command line")
+	}
+
+	cfg, err := loader.Load()
+	if err != nil {
+		logrus.WithError(err).Fatal("failed to load the configuration")
+	}
+
+	// the servers stop on SIGTERM/SIGINT, the background jobs only once the servers are drained.
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer stop()
+
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	g, ctx := errgroup.WithContext(ctx)
+
+	settings := config.NewHolder(cfg)
+
+	g.Go(func() error {
+		reloadOnHangup(ctx, loader, settings)
+		return nil
+	})
+
+	monitor := health.NewMonitor(settings)
+	rateHistory := file.NewStore(settings)
+	ratesHub := watch.NewHub()
+	store := inmemory.NewStore(settings, monitor, rateHistory, ratesHub)
+	quotes := inmemory.NewQuoteStore()
+
 	// start background jobs
+	g.Go(func() error {
+		backgroundjobs.RunCacheCleaner(jobsCtx, store, quotes, settings)
+		return nil
+	})
+
+	g.Go(func() error {
+		backgroundjobs.RunExchangeRatesRefresher(jobsCtx, store, settings, monitor)
+		return nil
+	})
+
 	// start the servers
+	shutdown := make(chan struct{})
 
-	g, ctx := errgroup.WithContext(context.Background())
+	grpcServer := grpc.NewServer()
+	pb.RegisterCurrencyConverterServiceServer(grpcServer,
+		server.NewServer(store, quotes, rateHistory, ratesHub, monitor, settings, shutdown))
 
-	store := inmemory.NewStore()
+	healthServer := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
 
 	g.Go(func() error {
-		backgroundjobs.RunCacheCleaner(ctx, store, 5*time.Minute)
+		backgroundjobs.RunHealthReporter(jobsCtx, monitor, healthServer, settings,
+			"", pb.CurrencyConverterService_ServiceDesc.ServiceName)
 		return nil
 	})
 
+	grpcListener, err := net.Listen("tcp", cfg.Server.GRPCAddr)
+	if err != nil {
+		logrus.WithError(err).Fatalf("failed to listen on [%s]", cfg.Server.GRPCAddr)
+	}
+
 	g.Go(func() error {
-		backgroundjobs.RunExchangeRatesRefresher(ctx, store, 5*time.Minute)
+		logrus.Infof("gRPC server listening on [%s]", grpcListener.Addr())
+		return grpcServer.Serve(grpcListener)
+	})
+
+	gatewayServer, gatewayConn, err := newGatewayServer(cfg.Server.HTTPAddr, grpcListener.Addr().String(), monitor)
+	if err != nil {
+		logrus.WithError(err).Fatal("failed to build the REST gateway")
+	}
+
+	g.Go(func() error {
+		logrus.Infof("REST gateway listening on [%s]", cfg.Server.HTTPAddr)
+		if err := gatewayServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
 		return nil
 	})
 
+	g.Go(func() error {
+		<-ctx.Done()
+		logrus.Info("shutting down, draining in-flight requests")
+
+		// stop advertising the service as ready, before draining
+		monitor.ShuttingDown()
+		healthServer.Shutdown()
+
+		// end the streams, which would otherwise hold the drain until the shutdown timeout.
+		close(shutdown)
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), settings.Get().Server.ShutdownTimeout)
+		defer cancel()
+
+		err := gatewayServer.Shutdown(shutdownCtx)
+		if cErr := gatewayConn.Close(); cErr != nil {
+			logrus.WithError(cErr).Warn("failed to close the REST gateway connection")
+		}
+
+		gracefulStop(shutdownCtx, grpcServer)
+		stopJobs()
+
+		return err
+	})
+
 	if err := g.Wait(); err != nil {
-		log.Fatal(err)
+		logrus.Fatal(err)
+	}
+}
+
+// reloadOnHangup reloads the configuration on every SIGHUP, until ctx is done.
+// An invalid configuration is logged and rejected, the current one stays in use.
+func reloadOnHangup(ctx context.Context, loader *config.Loader, settings *config.Holder) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	defer signal.Stop(hangup)
+
+	for {
+		select {
+		case <-hangup:
+			changes, err := settings.Reload(loader)
+			if err != nil {
+				logrus.WithError(err).Error("rejected the reloaded configuration, keeping the current one")
+				continue
+			}
+
+			if len(changes) == 0 {
+				logrus.Info("configuration reloaded, nothing changed")
+				continue
+			}
+
+			for _, change := range changes {
+				logrus.Infof("configuration changed: %s", change)
+
+				if strings.HasPrefix(change, "server.grpcAddr") || strings.HasPrefix(change, "server.httpAddr") {
+					logrus.Warn("the listen addresses are applied only on restart")
+				}
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// incomingHeader forwards the client identifier header to the gRPC server, on top of the default headers.
+func incomingHeader(key string) (string, bool) {
+	if strings.EqualFold(key, server.ClientIDHeader) {
+		return server.ClientIDHeader, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// newGatewayServer builds the grpc-gateway REST proxy forwarding to the gRPC server at grpcEndpoint,
+// serving the liveness and readiness of the monitor on /healthz and /readyz.
+// The returned connection is owned by the caller and has to be closed once the gateway is shut down.
+func newGatewayServer(addr, grpcEndpoint string, monitor *health.Monitor) (*http.Server, *grpc.ClientConn, error) {
+	conn, err := grpc.Dial(grpcEndpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(incomingHeader))
+	if err = pb.RegisterCurrencyConverterServiceHandler(context.Background(), mux, conn); err != nil {
+		_ = conn.Close()
+		return nil, nil, err
+	}
+
+	if err = mux.HandlePath(http.MethodGet, "/healthz", healthHandler(monitor.Live)); err != nil {
+		_ = conn.Close()
+		return nil, nil, err
+	}
+
+	if err = mux.HandlePath(http.MethodGet, "/readyz", healthHandler(monitor.Ready)); err != nil {
+		_ = conn.Close()
+		return nil, nil, err
+	}
+
+	return &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}, conn, nil
+}
+
+// healthHandler responds 200 when check passes, and 503 with the reason when it does not.
+func healthHandler(check func() error) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+
+		if err := check(); err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = fmt.Fprintln(w, err)
+			return
+		}
+
+		_, _ = fmt.Fprintln(w, "ok")
+	}
+}
+
+// gracefulStop waits for the in-flight RPCs to finish, and forcefully stops the server once ctx is done.
+func gracefulStop(ctx context.Context, grpcServer *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		logrus.Warn("shutdown timeout reached, stopping the gRPC server forcefully")
+		grpcServer.Stop()
 	}
 }
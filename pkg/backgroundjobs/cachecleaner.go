@@ -2,18 +2,21 @@ package backgroundjobs
 
 import (
 	"context"
-	"os/signal"
-	"syscall"
 	"time"
 
+	"github.com/sirupsen/logrus"
+
 	"currency-converter/internal/cache"
+	"currency-converter/internal/config"
 )
 
-func RunCacheCleaner(ctx context.Context, store cache.Store, interval time.Duration) {
-	var cancelFunc context.CancelFunc
-	ctx, cancelFunc = signal.NotifyContext(ctx, syscall.SIGTERM, syscall.SIGINT)
-	defer cancelFunc()
+// RunCacheCleaner cleans up the expired cache entries and quotes every cleanup interval of settings, until ctx is done.
+// A reloaded cleanup interval is applied from the next tick.
+func RunCacheCleaner(ctx context.Context, store cache.Store, quotes cache.QuoteStore, settings *config.Holder) {
+	updates, unsubscribe := settings.Subscribe()
+	defer unsubscribe()
 
+	interval := settings.Get().Jobs.CleanupInterval
 	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
@@ -21,9 +24,17 @@ func RunCacheCleaner(ctx context.Context, store cache.Store, interval time.Durat
 		select {
 		case <-ticker.C:
 			store.CleanupAllExpired()
+			quotes.CleanupAllExpired()
+
+		case <-updates:
+			if next := settings.Get().Jobs.CleanupInterval; next != interval {
+				logrus.Infof("cache cleaner interval changed from [%s] to [%s]", interval, next)
+
+				interval = next
+				ticker.Reset(interval)
+			}
 
 		case <-ctx.Done():
-			cancelFunc()
 			return
 		}
 	}
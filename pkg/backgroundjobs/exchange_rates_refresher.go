@@ -2,34 +2,54 @@ package backgroundjobs
 
 import (
 	"context"
-	"os/signal"
-	"syscall"
 	"time"
 
 	"github.com/sirupsen/logrus"
 
 	"currency-converter/internal/cache"
-	"currency-converter/internal/exchange"
+	"currency-converter/internal/config"
+	"currency-converter/internal/health"
 )
 
-func RunExchangeRatesRefresher(ctx context.Context, store cache.Store, interval time.Duration) {
+// RunExchangeRatesRefresher refreshes the exchange rates of the configured providers right away and then every
+// refresh interval of settings, until ctx is done. A reloaded interval is applied from the next tick, and the
+// providers are read from the current configuration on every tick. The outcome of every refresh is reported to
+// the monitor.
+// The caller owns ctx, so that the rates keep being refreshed while the servers drain on shutdown.
+func RunExchangeRatesRefresher(ctx context.Context, store cache.Store, settings *config.Holder, monitor *health.Monitor) {
+	updates, unsubscribe := settings.Subscribe()
+	defer unsubscribe()
+
 	// refreshes the exchange rates for the default or first successful provider
-	var cancelFunc context.CancelFunc
-	ctx, cancelFunc = signal.NotifyContext(ctx, syscall.SIGTERM, syscall.SIGINT)
-	defer cancelFunc()
+	refresh := func() {
+		err := store.RefreshExchangeRates(settings.Get().Exchange.Providers)
+		if err != nil {
+			logrus.WithError(err).Error("failed to refresh exchange rates")
+		}
+
+		monitor.RefreshCompleted(err)
+	}
 
+	refresh()
+
+	interval := settings.Get().Jobs.RefreshInterval
 	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
 	for {
 		select {
 		case <-ticker.C:
-			if err := store.RefreshExchangeRates(exchange.GetSupportedProviders()); err != nil {
-				logrus.WithError(err).Error("failed to refresh exchange rates")
+			refresh()
+
+		case <-updates:
+			if next := settings.Get().Jobs.RefreshInterval; next != interval {
+				logrus.Infof("exchange rates refresher interval changed from [%s] to [%s]", interval, next)
+
+				interval = next
+				ticker.Reset(interval)
 			}
 
 		case <-ctx.Done():
-			cancelFunc()
 			return
 		}
 	}
@@ -0,0 +1,66 @@
+package backgroundjobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"currency-converter/internal/config"
+	"currency-converter/internal/health"
+)
+
+// RunHealthReporter updates the serving status of the services of the gRPC health server from the readiness
+// of the monitor, every check interval of settings, until ctx is done.
+func RunHealthReporter(
+	ctx context.Context,
+	monitor *health.Monitor,
+	healthServer *grpchealth.Server,
+	settings *config.Holder,
+	services ...string) {
+	updates, unsubscribe := settings.Subscribe()
+	defer unsubscribe()
+
+	var previous error
+	report := func() {
+		servingStatus := healthpb.HealthCheckResponse_SERVING
+
+		err := monitor.Ready()
+		if err != nil {
+			servingStatus = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+
+		if (err == nil) != (previous == nil) {
+			logrus.WithError(err).Infof("health status changed to [%s]", servingStatus)
+		}
+		previous = err
+
+		for _, service := range services {
+			healthServer.SetServingStatus(service, servingStatus)
+		}
+	}
+
+	report()
+
+	interval := settings.Get().Health.CheckInterval
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			report()
+
+		case <-updates:
+			if next := settings.Get().Health.CheckInterval; next != interval {
+				interval = next
+				ticker.Reset(interval)
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -1,6 +1,19 @@
 package converter
 
-func Convert(rate float32, from float64) float64 {
-	// UnImplemented
-	return 0
+// Convert returns the amount converted with the exchange rate, exactly.
+// Returns ErrOverflow if the converted amount is too large.
+func Convert(rate, amount Decimal) (Decimal, error) {
+	return amount.Mul(rate)
+}
+
+// CrossRate returns the exchange rate from a source to a target currency, from the rates of both of them
+// against the same base currency, i.e. toRate / fromRate, rounded half to even to MaxScale digits.
+// e.g. with USD as base, EUR at 0.9 and INR at 81, the EUR to INR rate is 90.
+func CrossRate(fromRate, toRate Decimal) (Decimal, error) {
+	rate, err := toRate.Quo(fromRate, MaxScale, HalfEven)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	return rate.Trim(), nil
 }
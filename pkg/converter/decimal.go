@@ -0,0 +1,320 @@
+package converter
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+const (
+	// MaxIntegerDigits is the maximum number of digits of the integer part of a Decimal.
+	MaxIntegerDigits = 24
+
+	// MaxScale is the maximum number of digits of the fractional part of a Decimal.
+	MaxScale = 18
+)
+
+var (
+	// ErrInvalidDecimal is returned when a string is not a plain decimal number, e.g. "12.50".
+	ErrInvalidDecimal = errors.New("invalid decimal number")
+
+	// ErrOverflow is returned when a Decimal would have more than MaxIntegerDigits integer digits.
+	ErrOverflow = errors.New("decimal overflow")
+
+	// ErrDivisionByZero is returned when dividing by a zero Decimal.
+	ErrDivisionByZero = errors.New("decimal division by zero")
+)
+
+var bigTen = big.NewInt(10)
+
+// Decimal is an exact base 10 number, with the value coefficient * 10^-scale.
+// The zero value is 0. A Decimal is immutable, all the operations return a new one.
+type Decimal struct {
+	coefficient *big.Int
+	scale       int32
+}
+
+// NewDecimal returns the Decimal coefficient * 10^-scale.
+func NewDecimal(coefficient int64, scale int32) Decimal {
+	return Decimal{coefficient: big.NewInt(coefficient), scale: scale}
+}
+
+// ParseDecimal parses a plain decimal number, with an optional sign and fractional part, e.g. "-1234.5678".
+// The number is kept exactly as written, no rounding happens.
+func ParseDecimal(value string) (Decimal, error) {
+	s := value
+	if strings.HasPrefix(s, "+") || strings.HasPrefix(s, "-") {
+		s = s[1:]
+	}
+
+	integer, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		integer, fraction = s[:i], s[i+1:]
+	}
+
+	if integer == "" && fraction == "" || !isDigits(integer) || !isDigits(fraction) {
+		return Decimal{}, ErrInvalidDecimal
+	}
+
+	if len(fraction) > MaxScale {
+		return Decimal{}, ErrOverflow
+	}
+
+	coefficient, ok := new(big.Int).SetString(value[:len(value)-len(s)]+integer+fraction, 10)
+	if !ok {
+		return Decimal{}, ErrInvalidDecimal
+	}
+
+	d := Decimal{coefficient: coefficient, scale: int32(len(fraction))}
+	if err := d.checkOverflow(); err != nil {
+		return Decimal{}, err
+	}
+
+	return d, nil
+}
+
+// NewFromFloat32 returns the shortest Decimal which converts back to the same float32.
+// It recovers the digits quoted by an exchange provider, before they were stored in a float32.
+func NewFromFloat32(value float32) Decimal {
+	d, err := ParseDecimal(strconv.FormatFloat(float64(value), 'f', -1, 32))
+	if err != nil {
+		// NaN, Inf or out of range, none of which is a valid exchange rate.
+		return Decimal{}
+	}
+
+	return d
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (d Decimal) coef() *big.Int {
+	if d.coefficient == nil {
+		return new(big.Int)
+	}
+
+	return d.coefficient
+}
+
+// checkOverflow returns ErrOverflow if the integer part of d has more than MaxIntegerDigits digits.
+func (d Decimal) checkOverflow() error {
+	limit := new(big.Int).Exp(bigTen, big.NewInt(int64(MaxIntegerDigits)+int64(d.scale)), nil)
+	if new(big.Int).Abs(d.coef()).Cmp(limit) >= 0 {
+		return ErrOverflow
+	}
+
+	return nil
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of d.
+func (d Decimal) Sign() int {
+	return d.coef().Sign()
+}
+
+// IsZero returns true if d is 0.
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Scale returns the number of digits of the fractional part of d.
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// Cmp compares d and other and returns -1, 0 or +1.
+func (d Decimal) Cmp(other Decimal) int {
+	a, b := align(d, other)
+	return a.Cmp(b)
+}
+
+// align returns the coefficients of a and b at the same scale.
+func align(a, b Decimal) (x, y *big.Int) {
+	x, y = a.coef(), b.coef()
+
+	switch {
+	case a.scale < b.scale:
+		x = new(big.Int).Mul(x, pow10(b.scale-a.scale))
+	case a.scale > b.scale:
+		y = new(big.Int).Mul(y, pow10(a.scale-b.scale))
+	}
+
+	return x, y
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+// Add returns the exact sum d + other.
+func (d Decimal) Add(other Decimal) (Decimal, error) {
+	x, y := align(d, other)
+
+	sum := Decimal{coefficient: new(big.Int).Add(x, y), scale: maxScale(d, other)}
+	if err := sum.checkOverflow(); err != nil {
+		return Decimal{}, err
+	}
+
+	return sum, nil
+}
+
+// Sub returns the exact difference d - other.
+func (d Decimal) Sub(other Decimal) (Decimal, error) {
+	return d.Add(Decimal{coefficient: new(big.Int).Neg(other.coef()), scale: other.scale})
+}
+
+func maxScale(a, b Decimal) int32 {
+	if a.scale > b.scale {
+		return a.scale
+	}
+
+	return b.scale
+}
+
+// Mul returns the exact product d * other, rounded half to even to MaxScale digits if it has more.
+func (d Decimal) Mul(other Decimal) (Decimal, error) {
+	product := Decimal{
+		coefficient: new(big.Int).Mul(d.coef(), other.coef()),
+		scale:       d.scale + other.scale,
+	}
+
+	if product.scale > MaxScale {
+		product = product.Round(MaxScale, HalfEven)
+	}
+
+	if err := product.checkOverflow(); err != nil {
+		return Decimal{}, err
+	}
+
+	return product, nil
+}
+
+// Quo returns the quotient d / other, rounded with the rounding mode to scale digits of fractional part.
+// The quotient is rounded only once, from its exact value.
+func (d Decimal) Quo(other Decimal, scale int32, mode RoundingMode) (Decimal, error) {
+	if other.IsZero() {
+		return Decimal{}, ErrDivisionByZero
+	}
+
+	// d / other * 10^scale = d.coefficient * 10^(scale + other.scale) / (other.coefficient * 10^d.scale)
+	numerator := new(big.Int).Mul(d.coef(), pow10(scale+other.scale))
+	denominator := new(big.Int).Mul(other.coef(), pow10(d.scale))
+
+	quotient, remainder := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+
+	// compare twice the remainder with the divisor, to find out if it is more or less than half of it.
+	twice := new(big.Int).Abs(remainder)
+	twice.Lsh(twice, 1)
+
+	sign := d.Sign() * other.Sign()
+	if mode.roundUp(quotient, remainder, twice.Cmp(new(big.Int).Abs(denominator)), sign) {
+		quotient.Add(quotient, big.NewInt(int64(sign)))
+	}
+
+	result := Decimal{coefficient: quotient, scale: scale}
+	if err := result.checkOverflow(); err != nil {
+		return Decimal{}, err
+	}
+
+	return result, nil
+}
+
+// Round returns d rounded with the rounding mode to places digits of fractional part.
+func (d Decimal) Round(places int32, mode RoundingMode) Decimal {
+	if places >= d.scale {
+		return d
+	}
+
+	divisor := pow10(d.scale - places)
+	quotient, remainder := new(big.Int).QuoRem(d.coef(), divisor, new(big.Int))
+
+	// compare twice the remainder with the divisor, to find out if it is more or less than half of it.
+	twice := new(big.Int).Abs(remainder)
+	twice.Lsh(twice, 1)
+
+	if mode.roundUp(quotient, remainder, twice.Cmp(divisor), d.Sign()) {
+		quotient.Add(quotient, big.NewInt(int64(d.Sign())))
+	}
+
+	return Decimal{coefficient: quotient, scale: places}
+}
+
+// Trim returns d without the trailing zeros of its fractional part, e.g. "1.2500" becomes "1.25".
+func (d Decimal) Trim() Decimal {
+	coefficient, scale := d.coef(), d.scale
+	remainder := new(big.Int)
+
+	for scale > 0 {
+		quotient, r := new(big.Int).QuoRem(coefficient, bigTen, remainder)
+		if r.Sign() != 0 {
+			break
+		}
+
+		coefficient, scale = quotient, scale-1
+	}
+
+	return Decimal{coefficient: coefficient, scale: scale}
+}
+
+// Float32 returns the nearest float32 to d.
+func (d Decimal) Float32() float32 {
+	f, _ := strconv.ParseFloat(d.String(), 32)
+	return float32(f)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, so that a Decimal can be read from a plain decimal number.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	parsed, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// String returns d with all the digits of its scale, e.g. "-1234.5600".
+func (d Decimal) String() string {
+	return d.format(d.scale)
+}
+
+// StringFixed returns d formatted with exactly places digits, rounded half to even if it has more.
+// Round d first to format it with another rounding mode.
+func (d Decimal) StringFixed(places int32) string {
+	return d.Round(places, HalfEven).format(places)
+}
+
+// format returns d with exactly places digits of fractional part, places must not be less than d.scale.
+func (d Decimal) format(places int32) string {
+	coefficient := d.coef()
+	if places > d.scale {
+		coefficient = new(big.Int).Mul(coefficient, pow10(places-d.scale))
+	}
+
+	digits := new(big.Int).Abs(coefficient).String()
+	if places > 0 {
+		if pad := int(places) + 1 - len(digits); pad > 0 {
+			digits = strings.Repeat("0", pad) + digits
+		}
+		digits = digits[:len(digits)-int(places)] + "." + digits[len(digits)-int(places):]
+	}
+
+	if coefficient.Sign() < 0 {
+		return "-" + digits
+	}
+
+	return digits
+}
@@ -0,0 +1,187 @@
+package converter
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func mustParse(t *testing.T, value string) Decimal {
+	t.Helper()
+
+	d, err := ParseDecimal(value)
+	if err != nil {
+		t.Fatalf("failed to parse [%s]: %v", value, err)
+	}
+
+	return d
+}
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		value string
+		mode  RoundingMode
+		want  string
+	}{
+		{value: "2.5", mode: HalfEven, want: "2"},
+		{value: "3.5", mode: HalfEven, want: "4"},
+		{value: "-2.5", mode: HalfEven, want: "-2"},
+		{value: "-3.5", mode: HalfEven, want: "-4"},
+		{value: "2.51", mode: HalfEven, want: "3"},
+
+		{value: "2.5", mode: HalfUp, want: "3"},
+		{value: "-2.5", mode: HalfUp, want: "-3"},
+		{value: "2.49", mode: HalfUp, want: "2"},
+		{value: "-2.49", mode: HalfUp, want: "-2"},
+
+		{value: "2.5", mode: Floor, want: "2"},
+		{value: "-2.5", mode: Floor, want: "-3"},
+		{value: "2.1", mode: Floor, want: "2"},
+		{value: "-2.1", mode: Floor, want: "-3"},
+
+		{value: "2.5", mode: Ceiling, want: "3"},
+		{value: "-2.5", mode: Ceiling, want: "-2"},
+		{value: "2.1", mode: Ceiling, want: "3"},
+		{value: "-2.1", mode: Ceiling, want: "-2"},
+
+		{value: "2", mode: Ceiling, want: "2"},
+		{value: "-2.00", mode: Floor, want: "-2"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.mode.String()+" "+test.value, func(t *testing.T) {
+			if got := mustParse(t, test.value).Round(0, test.mode).String(); got != test.want {
+				t.Errorf("got [%s], want [%s]", got, test.want)
+			}
+		})
+	}
+}
+
+func TestQuo(t *testing.T) {
+	tests := []struct {
+		d, other string
+		mode     RoundingMode
+		want     string
+	}{
+		{d: "1", other: "8", mode: HalfEven, want: "0.12"},
+		{d: "3", other: "8", mode: HalfEven, want: "0.38"},
+		{d: "1", other: "8", mode: HalfUp, want: "0.13"},
+		{d: "-1", other: "8", mode: HalfUp, want: "-0.13"},
+		{d: "1", other: "-8", mode: HalfEven, want: "-0.12"},
+		{d: "1", other: "3", mode: Floor, want: "0.33"},
+		{d: "-1", other: "3", mode: Floor, want: "-0.34"},
+		{d: "1", other: "3", mode: Ceiling, want: "0.34"},
+		{d: "-1", other: "3", mode: Ceiling, want: "-0.33"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.mode.String()+" "+test.d+"/"+test.other, func(t *testing.T) {
+			got, err := mustParse(t, test.d).Quo(mustParse(t, test.other), 2, test.mode)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got.String() != test.want {
+				t.Errorf("got [%s], want [%s]", got, test.want)
+			}
+		})
+	}
+
+	if _, err := mustParse(t, "1").Quo(Decimal{}, 2, HalfEven); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("got the error [%v] dividing by zero, want [%v]", err, ErrDivisionByZero)
+	}
+}
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+		err   error
+	}{
+		{value: "1234.5678", want: "1234.5678"},
+		{value: "-5", want: "-5"},
+		{value: "+0.50", want: "0.50"},
+		{value: ".5", want: "0.5"},
+		{value: "0." + strings.Repeat("1", MaxScale), want: "0." + strings.Repeat("1", MaxScale)},
+		{value: strings.Repeat("9", MaxIntegerDigits), want: strings.Repeat("9", MaxIntegerDigits)},
+
+		{value: "0." + strings.Repeat("1", MaxScale+1), err: ErrOverflow},
+		{value: "1" + strings.Repeat("0", MaxIntegerDigits), err: ErrOverflow},
+
+		{value: "", err: ErrInvalidDecimal},
+		{value: ".", err: ErrInvalidDecimal},
+		{value: "-", err: ErrInvalidDecimal},
+		{value: "NaN", err: ErrInvalidDecimal},
+		{value: "Inf", err: ErrInvalidDecimal},
+		{value: "1e3", err: ErrInvalidDecimal},
+		{value: "1,000", err: ErrInvalidDecimal},
+		{value: "1.2.3", err: ErrInvalidDecimal},
+		{value: "--5", err: ErrInvalidDecimal},
+		{value: " 5", err: ErrInvalidDecimal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			got, err := ParseDecimal(test.value)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("got the error [%v], want [%v]", err, test.err)
+			}
+
+			if test.err == nil && got.String() != test.want {
+				t.Errorf("got [%s], want [%s]", got, test.want)
+			}
+		})
+	}
+}
+
+func TestMaxScale(t *testing.T) {
+	tiny := mustParse(t, "0."+strings.Repeat("0", MaxScale-1)+"5")
+
+	// the product is rounded half to even to MaxScale digits.
+	product, err := tiny.Mul(mustParse(t, "0.5"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if product.Scale() != MaxScale {
+		t.Errorf("got the scale %d, want %d", product.Scale(), MaxScale)
+	}
+
+	if want := "0." + strings.Repeat("0", MaxScale-1) + "2"; product.String() != want {
+		t.Errorf("got [%s], want [%s]", product, want)
+	}
+
+	huge := mustParse(t, strings.Repeat("9", MaxIntegerDigits))
+
+	if _, err = huge.Add(mustParse(t, "1")); !errors.Is(err, ErrOverflow) {
+		t.Errorf("got the error [%v] adding past the integer digits, want [%v]", err, ErrOverflow)
+	}
+
+	if _, err = huge.Mul(mustParse(t, "10")); !errors.Is(err, ErrOverflow) {
+		t.Errorf("got the error [%v] multiplying past the integer digits, want [%v]", err, ErrOverflow)
+	}
+
+	if _, err = Convert(mustParse(t, "1000000"), huge); !errors.Is(err, ErrOverflow) {
+		t.Errorf("got the error [%v] converting past the integer digits, want [%v]", err, ErrOverflow)
+	}
+}
+
+func TestCrossRate(t *testing.T) {
+	rate, err := CrossRate(mustParse(t, "0.9"), mustParse(t, "81"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rate.String() != "90" {
+		t.Errorf("got [%s], want [90]", rate)
+	}
+
+	rate, err = CrossRate(mustParse(t, "3"), mustParse(t, "1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rate.Scale() != MaxScale {
+		t.Errorf("got the scale %d, want %d", rate.Scale(), MaxScale)
+	}
+}
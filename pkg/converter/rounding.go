@@ -0,0 +1,87 @@
+package converter
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// RoundingMode is the way a Decimal is rounded to fewer digits.
+type RoundingMode int
+
+const (
+	// HalfEven rounds to the nearest neighbour, and to the even neighbour when equidistant. Used for accounting.
+	HalfEven RoundingMode = iota
+
+	// HalfUp rounds to the nearest neighbour, and away from zero when equidistant. Used for display.
+	HalfUp
+
+	// Floor rounds towards negative infinity. Used for payouts.
+	Floor
+
+	// Ceiling rounds towards positive infinity. Used for charges.
+	Ceiling
+)
+
+var roundingModeNames = map[RoundingMode]string{
+	HalfEven: "half-even",
+	HalfUp:   "half-up",
+	Floor:    "floor",
+	Ceiling:  "ceiling",
+}
+
+// String returns the name of the rounding mode, e.g. "half-even".
+func (mode RoundingMode) String() string {
+	if name, present := roundingModeNames[mode]; present {
+		return name
+	}
+
+	return fmt.Sprintf("RoundingMode(%d)", int(mode))
+}
+
+// ParseRoundingMode returns the rounding mode for its name, e.g. "half-up".
+func ParseRoundingMode(name string) (RoundingMode, error) {
+	for mode, modeName := range roundingModeNames {
+		if modeName == name {
+			return mode, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown rounding mode [%s]", name)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, so that a rounding mode can be read from its name.
+func (mode *RoundingMode) UnmarshalText(text []byte) error {
+	parsed, err := ParseRoundingMode(string(text))
+	if err != nil {
+		return err
+	}
+
+	*mode = parsed
+
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (mode RoundingMode) MarshalText() ([]byte, error) {
+	return []byte(mode.String()), nil
+}
+
+// roundUp returns true if the truncated quotient has to be moved one step away from zero, for the rounding mode.
+// twiceRemainderCmp is the comparison of twice the absolute remainder with the divisor, sign is the sign of the
+// rounded number.
+func (mode RoundingMode) roundUp(quotient *big.Int, remainder *big.Int, twiceRemainderCmp, sign int) bool {
+	if remainder.Sign() == 0 {
+		return false
+	}
+
+	switch mode {
+	case HalfUp:
+		return twiceRemainderCmp >= 0
+	case Floor:
+		return sign < 0
+	case Ceiling:
+		return sign > 0
+	default:
+		return twiceRemainderCmp > 0 || twiceRemainderCmp == 0 && quotient.Bit(0) == 1
+	}
+}
@@ -0,0 +1,53 @@
+package currency
+
+// symbol holds the CLDR symbols of a currency.
+type symbol struct {
+	symbol string
+	narrow string
+}
+
+// symbols holds the CLDR symbols of the currencies which have one, the others are written with their code.
+var symbols = map[string]symbol{
+	"AUD": {symbol: "A$", narrow: "$"},
+	"BDT": {symbol: "৳", narrow: "৳"},
+	"BRL": {symbol: "R$", narrow: "R$"},
+	"CAD": {symbol: "CA$", narrow: "$"},
+	"CNY": {symbol: "CN¥", narrow: "¥"},
+	"CRC": {symbol: "₡", narrow: "₡"},
+	"CZK": {symbol: "CZK", narrow: "Kč"},
+	"DKK": {symbol: "DKK", narrow: "kr."},
+	"EGP": {symbol: "EGP", narrow: "E£"},
+	"EUR": {symbol: "€", narrow: "€"},
+	"GBP": {symbol: "£", narrow: "£"},
+	"GHS": {symbol: "GHS", narrow: "GH₵"},
+	"HKD": {symbol: "HK$", narrow: "$"},
+	"HUF": {symbol: "HUF", narrow: "Ft"},
+	"ILS": {symbol: "₪", narrow: "₪"},
+	"INR": {symbol: "₹", narrow: "₹"},
+	"JPY": {symbol: "¥", narrow: "¥"},
+	"KHR": {symbol: "KHR", narrow: "៛"},
+	"KRW": {symbol: "₩", narrow: "₩"},
+	"KZT": {symbol: "KZT", narrow: "₸"},
+	"LAK": {symbol: "LAK", narrow: "₭"},
+	"MNT": {symbol: "MNT", narrow: "₮"},
+	"MXN": {symbol: "MX$", narrow: "$"},
+	"NGN": {symbol: "NGN", narrow: "₦"},
+	"NOK": {symbol: "NOK", narrow: "kr"},
+	"NZD": {symbol: "NZ$", narrow: "$"},
+	"PHP": {symbol: "₱", narrow: "₱"},
+	"PLN": {symbol: "PLN", narrow: "zł"},
+	"PYG": {symbol: "PYG", narrow: "₲"},
+	"RUB": {symbol: "RUB", narrow: "₽"},
+	"SEK": {symbol: "SEK", narrow: "kr"},
+	"SGD": {symbol: "SGD", narrow: "$"},
+	"THB": {symbol: "THB", narrow: "฿"},
+	"TRY": {symbol: "TRY", narrow: "₺"},
+	"TWD": {symbol: "NT$", narrow: "$"},
+	"UAH": {symbol: "UAH", narrow: "₴"},
+	"USD": {symbol: "$", narrow: "$"},
+	"VND": {symbol: "₫", narrow: "₫"},
+	"XAF": {symbol: "FCFA", narrow: "FCFA"},
+	"XOF": {symbol: "F CFA", narrow: "F CFA"},
+	"ZAR": {symbol: "ZAR", narrow: "R"},
+	"BTC": {symbol: "₿", narrow: "₿"},
+}
@@ -0,0 +1,111 @@
+package locale
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"currency-converter/pkg/converter"
+	"currency-converter/pkg/currency"
+)
+
+// Format returns the amount of the currency written in the locale with the minor units of the currency,
+// e.g. "1.234,56 €" in de-DE and "₹1,00,000.00" in en-IN. The amount should already be rounded to the minor units,
+// it is rounded half to even otherwise.
+func Format(amount converter.Decimal, l Locale, c currency.Currency) string {
+	digits := amount.StringFixed(c.MinorUnits)
+
+	negative := strings.HasPrefix(digits, "-")
+	number := separate(strings.TrimPrefix(digits, "-"), l)
+
+	symbol := c.Symbol
+	if c.Code == l.Currency {
+		symbol = c.NarrowSymbol
+	}
+
+	var b strings.Builder
+	if negative {
+		b.WriteString("-")
+	}
+
+	if l.SymbolAfter {
+		b.WriteString(number)
+		b.WriteString(symbolSpacing(l, symbol, false))
+		b.WriteString(symbol)
+	} else {
+		b.WriteString(symbol)
+		b.WriteString(symbolSpacing(l, symbol, true))
+		b.WriteString(number)
+	}
+
+	return b.String()
+}
+
+// FormatNumber returns the number written with the grouping and the decimal separator of the locale, with all its
+// digits, e.g. "0,006666666666666667" in de-DE. Unlike Format, it is neither rounded nor written with a symbol,
+// e.g. for the exchange rates.
+func FormatNumber(number converter.Decimal, l Locale) string {
+	digits := number.String()
+
+	if strings.HasPrefix(digits, "-") {
+		return "-" + separate(strings.TrimPrefix(digits, "-"), l)
+	}
+
+	return separate(digits, l)
+}
+
+// separate returns the unsigned plain decimal number, e.g. "1234.56", with the separators of the locale.
+func separate(digits string, l Locale) string {
+	integer, fraction := digits, ""
+	if i := strings.IndexByte(digits, '.'); i >= 0 {
+		integer, fraction = digits[:i], digits[i+1:]
+	}
+
+	number := group(integer, l)
+	if fraction != "" {
+		number += l.Decimal + fraction
+	}
+
+	return number
+}
+
+// group returns the digits of the integer part separated in groups, e.g. "1,00,000" in en-IN.
+func group(integer string, l Locale) string {
+	if l.Group == "" || len(integer) < l.PrimaryGroup+l.MinimumGrouping {
+		return integer
+	}
+
+	groups := []string{integer[len(integer)-l.PrimaryGroup:]}
+	for rest := integer[:len(integer)-l.PrimaryGroup]; rest != ""; {
+		size := l.SecondaryGroup
+		if size > len(rest) {
+			size = len(rest)
+		}
+
+		groups = append([]string{rest[len(rest)-size:]}, groups...)
+		rest = rest[:len(rest)-size]
+	}
+
+	return strings.Join(groups, l.Group)
+}
+
+// symbolSpacing returns the space between the symbol and the number.
+// As in CLDR, a symbol ending with a letter on the side of the number is always separated, e.g. "CHF 1.00".
+func symbolSpacing(l Locale, symbol string, before bool) string {
+	if l.SymbolSpace {
+		return nbsp
+	}
+
+	var r rune
+	if before {
+		r, _ = utf8.DecodeLastRuneInString(symbol)
+	} else {
+		r, _ = utf8.DecodeRuneInString(symbol)
+	}
+
+	if unicode.IsLetter(r) {
+		return nbsp
+	}
+
+	return ""
+}
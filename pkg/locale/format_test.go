@@ -0,0 +1,43 @@
+package locale
+
+import (
+	"testing"
+
+	"currency-converter/pkg/converter"
+)
+
+func TestFormatNumber(t *testing.T) {
+	tests := []struct {
+		number string
+		tag    string
+		want   string
+	}{
+		{number: "0.006666666666666667", tag: "en-US", want: "0.006666666666666667"},
+		{number: "0.006666666666666667", tag: "de-DE", want: "0,006666666666666667"},
+		{number: "0.006", tag: "ja-JP", want: "0.006"},
+		{number: "1234567.891", tag: "en-US", want: "1,234,567.891"},
+		{number: "1234567.891", tag: "de-DE", want: "1.234.567,891"},
+		{number: "1234567.891", tag: "en-IN", want: "12,34,567.891"},
+		{number: "150", tag: "fr-FR", want: "150"},
+		{number: "-1234.5", tag: "en-US", want: "-1,234.5"},
+		{number: "1234.5", tag: "", want: "1234.5"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.tag+" "+test.number, func(t *testing.T) {
+			l, present := Lookup(test.tag)
+			if !present {
+				t.Fatalf("unsupported locale [%s]", test.tag)
+			}
+
+			number, err := converter.ParseDecimal(test.number)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := FormatNumber(number, l); got != test.want {
+				t.Errorf("got [%s], want [%s]", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package locale
+
+import (
+	"strings"
+)
+
+// Locale holds the CLDR conventions used to write amounts in a locale.
+type Locale struct {
+	// Tag is the BCP 47 tag of the locale, e.g. "de-DE". Empty for Canonical.
+	Tag string
+
+	// Decimal separates the integer part from the fractional part, e.g. "," in de-DE.
+	Decimal string
+
+	// Group separates the groups of digits of the integer part, e.g. "." in de-DE. Empty when not grouped.
+	Group string
+
+	// PrimaryGroup is the size of the rightmost group of digits, e.g. 3.
+	PrimaryGroup int
+
+	// SecondaryGroup is the size of the other groups of digits, e.g. 2 in en-IN for "1,00,000".
+	SecondaryGroup int
+
+	// MinimumGrouping is the number of digits the leftmost group must have for the digits to be grouped,
+	// e.g. 2 in es-ES where "1234" is not grouped but "12.345" is.
+	MinimumGrouping int
+
+	// Currency is the currency of the region, written with its narrow symbol, e.g. "$" for AUD in en-AU.
+	Currency string
+
+	// SymbolAfter is true when the currency symbol is written after the number, e.g. "1.234,56 €" in de-DE.
+	SymbolAfter bool
+
+	// SymbolSpace is true when the currency symbol is separated from the number by a space, e.g. "R$ 1.234,56" in pt-BR.
+	SymbolSpace bool
+}
+
+// Canonical is the machine format of the amounts, a plain decimal number without grouping, e.g. "1234.56".
+var Canonical = Locale{Decimal: "."}
+
+// the spaces used as group separator by CLDR.
+const (
+	nbsp       = "\u00a0"
+	narrowNbsp = "\u202f"
+)
+
+// locales holds the supported locales by lower case tag.
+var locales = map[string]Locale{}
+
+// languages holds the locale used for a tag with only a language, e.g. "de" is read as "de-DE".
+var languages = map[string]string{
+	"de": "de-DE",
+	"en": "en-US",
+	"es": "es-ES",
+	"fr": "fr-FR",
+	"hi": "hi-IN",
+	"it": "it-IT",
+	"ja": "ja-JP",
+	"nl": "nl-NL",
+	"pl": "pl-PL",
+	"pt": "pt-BR",
+	"ru": "ru-RU",
+	"sv": "sv-SE",
+	"zh": "zh-CN",
+}
+
+func init() {
+	for _, l := range []Locale{
+		{
+			Tag: "de-CH", Decimal: ".", Group: "’", PrimaryGroup: 3, SecondaryGroup: 3, MinimumGrouping: 1,
+			Currency: "CHF", SymbolAfter: false, SymbolSpace: true,
+		},
+		{
+			Tag: "de-DE", Decimal: ",", Group: ".", PrimaryGroup: 3, SecondaryGroup: 3, MinimumGrouping: 1,
+			Currency: "EUR", SymbolAfter: true, SymbolSpace: true,
+		},
+		{
+			Tag: "en-AU", Decimal: ".", Group: ",", PrimaryGroup: 3, SecondaryGroup: 3, MinimumGrouping: 1,
+			Currency: "AUD", SymbolAfter: false, SymbolSpace: false,
+		},
+		{
+			Tag: "en-CA", Decimal: ".", Group: ",", PrimaryGroup: 3, SecondaryGroup: 3, MinimumGrouping: 1,
+			Currency: "CAD", SymbolAfter: false, SymbolSpace: false,
+		},
+		{
+			Tag: "en-GB", Decimal: ".", Group: ",", PrimaryGroup: 3, SecondaryGroup: 3, MinimumGrouping: 1,
+			Currency: "GBP", SymbolAfter: false, SymbolSpace: false,
+		},
+		{
+			Tag: "en-IN", Decimal: ".", Group: ",", PrimaryGroup: 3, SecondaryGroup: 2, MinimumGrouping: 1,
+			Currency: "INR", SymbolAfter: false, SymbolSpace: false,
+		},
+		{
+			Tag: "en-US", Decimal: ".", Group: ",", PrimaryGroup: 3, SecondaryGroup: 3, MinimumGrouping: 1,
+			Currency: "USD", SymbolAfter: false, SymbolSpace: false,
+		},
+		{
+			Tag: "es-ES", Decimal: ",", Group: ".", PrimaryGroup: 3, SecondaryGroup: 3, MinimumGrouping: 2,
+			Currency: "EUR", SymbolAfter: true, SymbolSpace: true,
+		},
+		{
+			Tag: "es-MX", Decimal: ".", Group: ",", PrimaryGroup: 3, SecondaryGroup: 3, MinimumGrouping: 1,
+			Currency: "MXN", SymbolAfter: false, SymbolSpace: false,
+		},
+		{
+			Tag: "fr-CH", Decimal: ",", Group: narrowNbsp, PrimaryGroup: 3, SecondaryGroup: 3, MinimumGrouping: 1,
+			Currency: "CHF", SymbolAfter: true, SymbolSpace: true,
+		},
+		{
+			Tag: "fr-FR", Decimal: ",", Group: narrowNbsp, PrimaryGroup: 3, SecondaryGroup: 3, MinimumGrouping: 1,
+			Currency: "EUR", SymbolAfter: true, SymbolSpace: true,
+		},
+		{
+			Tag: "hi-IN", Decimal: ".", Group: ",", PrimaryGroup: 3, SecondaryGroup: 2, MinimumGrouping: 1,
+			Currency: "INR", SymbolAfter: false, SymbolSpace: false,
+		},
+		{
+			Tag: "it-IT", Decimal: ",", Group: ".", PrimaryGroup: 3, SecondaryGroup: 3, MinimumGrouping: 1,
+			Currency: "EUR", SymbolAfter: true, SymbolSpace: true,
+		},
+		{
+			Tag: "ja-JP", Decimal: ".", Group: ",", PrimaryGroup: 3, SecondaryGroup: 3, MinimumGrouping: 1,
+			Currency: "JPY", SymbolAfter: false, SymbolSpace: false,
+		},
+		{
+			Tag: "nl-NL", Decimal: ",", Group: ".", PrimaryGroup: 3, SecondaryGroup: 3, MinimumGrouping: 1,
+			Currency: "EUR", SymbolAfter: false, SymbolSpace: true,
+		},
+		{
+			Tag: "pl-PL", Decimal: ",", Group: nbsp, PrimaryGroup: 3, SecondaryGroup: 3, MinimumGrouping: 2,
+			Currency: "PLN", SymbolAfter: true, SymbolSpace: true,
+		},
+		{
+			Tag: "pt-BR", Decimal: ",", Group: ".", PrimaryGroup: 3, SecondaryGroup: 3, MinimumGrouping: 1,
+			Currency: "BRL", SymbolAfter: false, SymbolSpace: true,
+		},
+		{
+			Tag: "ru-RU", Decimal: ",", Group: nbsp, PrimaryGroup: 3, SecondaryGroup: 3, MinimumGrouping: 1,
+			Currency: "RUB", SymbolAfter: true, SymbolSpace: true,
+		},
+		{
+			Tag: "sv-SE", Decimal: ",", Group: nbsp, PrimaryGroup: 3, SecondaryGroup: 3, MinimumGrouping: 1,
+			Currency: "SEK", SymbolAfter: true, SymbolSpace: true,
+		},
+		{
+			Tag: "zh-CN", Decimal: ".", Group: ",", PrimaryGroup: 3, SecondaryGroup: 3, MinimumGrouping: 1,
+			Currency: "CNY", SymbolAfter: false, SymbolSpace: false,
+		},
+	} {
+		locales[strings.ToLower(l.Tag)] = l
+	}
+}
+
+// Lookup returns the locale for the BCP 47 tag, which is case-insensitive and can use "_", e.g. "de_de".
+// A tag with only a language gets the locale of its main region, e.g. "de" is "de-DE".
+// The empty tag is Canonical.
+func Lookup(tag string) (Locale, bool) {
+	tag = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+	if tag == "" {
+		return Canonical, true
+	}
+
+	if l, present := locales[tag]; present {
+		return l, true
+	}
+
+	if main, present := languages[tag]; present {
+		return locales[strings.ToLower(main)], true
+	}
+
+	return Locale{}, false
+}
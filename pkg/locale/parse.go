@@ -0,0 +1,156 @@
+package locale
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"currency-converter/pkg/converter"
+	"currency-converter/pkg/currency"
+)
+
+var (
+	// ErrInvalidAmount is returned when an amount is not a number written in the locale.
+	ErrInvalidAmount = errors.New("amount is not a valid number")
+
+	// ErrNegativeAmount is returned when an amount is negative.
+	ErrNegativeAmount = errors.New("amount must not be negative")
+
+	// ErrNotFinite is returned for NaN and infinite amounts.
+	ErrNotFinite = errors.New("amount must be a finite number")
+
+	// ErrInvalidGrouping is returned when the digits of an amount are not grouped as in the locale.
+	ErrInvalidGrouping = errors.New("amount digits are not grouped as in the locale")
+
+	// ErrExcessPrecision is returned when an amount has more decimals than the minor units of its currency.
+	ErrExcessPrecision = errors.New("amount has more decimals than its currency")
+
+	// ErrAmountTooLarge is returned when an amount has more than converter.MaxIntegerDigits integer digits.
+	ErrAmountTooLarge = errors.New("amount is too large")
+)
+
+// groupVariants holds the separators accepted in place of the group separator of a locale, as typed by the users.
+var groupVariants = map[string][]string{
+	nbsp:       {" ", narrowNbsp},
+	narrowNbsp: {" ", nbsp},
+	"’":        {"'"},
+}
+
+// ParseAmount parses a positive amount of the currency written in the locale, e.g. "1.234,56 €" in de-DE.
+// The symbol or the code of the currency can be written before or after the number.
+// The amount must not have more significant decimals than the minor units of the currency.
+func ParseAmount(value string, l Locale, c currency.Currency) (converter.Decimal, error) {
+	s := strings.TrimSpace(value)
+
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "−") || strings.HasSuffix(s, "-") {
+		return converter.Decimal{}, ErrNegativeAmount
+	}
+
+	s = strings.TrimSpace(trimSymbol(strings.TrimPrefix(s, "+"), c))
+
+	lower := strings.ToLower(s)
+	if strings.Contains(lower, "nan") || strings.Contains(lower, "inf") || strings.Contains(s, "∞") {
+		return converter.Decimal{}, ErrNotFinite
+	}
+
+	integer, fraction := s, ""
+	if i := strings.Index(s, l.Decimal); i >= 0 {
+		integer, fraction = s[:i], s[i+len(l.Decimal):]
+	}
+
+	integer, err := ungroup(integer, l)
+	if err != nil {
+		return converter.Decimal{}, err
+	}
+
+	if !isDigits(fraction) {
+		return converter.Decimal{}, ErrInvalidAmount
+	}
+
+	if significant := strings.TrimRight(fraction, "0"); len(significant) > int(c.MinorUnits) {
+		return converter.Decimal{}, fmt.Errorf("%w: %s has %d decimals", ErrExcessPrecision, c.Code, c.MinorUnits)
+	}
+
+	plain := integer
+	if fraction != "" {
+		plain += "." + fraction
+	}
+
+	amount, err := converter.ParseDecimal(plain)
+	switch {
+	case errors.Is(err, converter.ErrOverflow):
+		return converter.Decimal{}, ErrAmountTooLarge
+	case err != nil:
+		return converter.Decimal{}, ErrInvalidAmount
+	}
+
+	return amount, nil
+}
+
+// trimSymbol returns s without the code or a symbol of the currency, written before or after the number.
+func trimSymbol(s string, c currency.Currency) string {
+	candidates := []string{c.Code, c.Symbol, c.NarrowSymbol}
+
+	// the longest first, so that "R$" is not read as "$".
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return len(candidates[i]) > len(candidates[j])
+	})
+
+	for _, candidate := range candidates {
+		switch {
+		case candidate == "":
+			continue
+		case len(s) >= len(candidate) && strings.EqualFold(s[:len(candidate)], candidate):
+			return s[len(candidate):]
+		case len(s) >= len(candidate) && strings.EqualFold(s[len(s)-len(candidate):], candidate):
+			return s[:len(s)-len(candidate)]
+		}
+	}
+
+	return s
+}
+
+// ungroup returns the digits of the integer part, once their grouping was checked against the locale.
+// e.g. "1,00,000" is "100000" in en-IN, but is not grouped as in en-US.
+func ungroup(integer string, l Locale) (string, error) {
+	if l.Group == "" || !strings.ContainsAny(integer, l.Group+strings.Join(groupVariants[l.Group], "")) {
+		if !isDigits(integer) {
+			return "", ErrInvalidAmount
+		}
+
+		return integer, nil
+	}
+
+	for _, variant := range groupVariants[l.Group] {
+		integer = strings.ReplaceAll(integer, variant, l.Group)
+	}
+
+	groups := strings.Split(integer, l.Group)
+	for i, group := range groups {
+		if group == "" || !isDigits(group) {
+			return "", ErrInvalidAmount
+		}
+
+		size := l.SecondaryGroup
+		if i == len(groups)-1 {
+			size = l.PrimaryGroup
+		}
+
+		if len(group) > size || i > 0 && len(group) != size {
+			return "", ErrInvalidGrouping
+		}
+	}
+
+	return strings.Join(groups, ""), nil
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
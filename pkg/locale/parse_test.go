@@ -0,0 +1,114 @@
+package locale
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"currency-converter/pkg/converter"
+	"currency-converter/pkg/currency"
+)
+
+func lookup(t *testing.T, tag, code string) (Locale, currency.Currency) {
+	t.Helper()
+
+	l, present := Lookup(tag)
+	if !present {
+		t.Fatalf("unsupported locale [%s]", tag)
+	}
+
+	c, present := currency.Lookup(code)
+	if !present {
+		t.Fatalf("unknown currency [%s]", code)
+	}
+
+	return l, c
+}
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		value    string
+		tag      string
+		currency string
+		want     string
+		err      error
+	}{
+		{value: "1,234.56", tag: "en-US", currency: "USD", want: "1234.56"},
+		{value: "$1,234.56", tag: "en-US", currency: "USD", want: "1234.56"},
+		{value: "1.234,56 €", tag: "de-DE", currency: "EUR", want: "1234.56"},
+		{value: "1 234,56 €", tag: "fr-FR", currency: "EUR", want: "1234.56"},
+		{value: "1,00,000", tag: "en-IN", currency: "INR", want: "100000"},
+		{value: "¥1,235", tag: "ja-JP", currency: "JPY", want: "1235"},
+		{value: "1234.50", tag: "", currency: "USD", want: "1234.50"},
+		{value: "12.500", tag: "en-US", currency: "USD", want: "12.500"},
+
+		{value: "-5", tag: "en-US", currency: "USD", err: ErrNegativeAmount},
+		{value: "5-", tag: "de-DE", currency: "EUR", err: ErrNegativeAmount},
+		{value: "NaN", tag: "en-US", currency: "USD", err: ErrNotFinite},
+		{value: "Inf", tag: "en-US", currency: "USD", err: ErrNotFinite},
+		{value: "∞", tag: "en-US", currency: "USD", err: ErrNotFinite},
+		{value: "1.234", tag: "en-US", currency: "USD", err: ErrExcessPrecision},
+		{value: "1.5", tag: "ja-JP", currency: "JPY", err: ErrExcessPrecision},
+		{value: "1,00,000", tag: "en-US", currency: "USD", err: ErrInvalidGrouping},
+		{value: "100,000", tag: "en-IN", currency: "INR", err: ErrInvalidGrouping},
+		{value: "1,2345", tag: "en-US", currency: "USD", err: ErrInvalidGrouping},
+		{value: "1.234,56", tag: "en-US", currency: "USD", err: ErrInvalidAmount},
+		{value: "1,,000", tag: "en-US", currency: "USD", err: ErrInvalidAmount},
+		{value: "abc", tag: "en-US", currency: "USD", err: ErrInvalidAmount},
+		{value: "", tag: "en-US", currency: "USD", err: ErrInvalidAmount},
+		{value: strings.Repeat("9", converter.MaxIntegerDigits+1), tag: "", currency: "USD", err: ErrAmountTooLarge},
+	}
+
+	for _, test := range tests {
+		t.Run(test.tag+" "+test.value, func(t *testing.T) {
+			l, c := lookup(t, test.tag, test.currency)
+
+			got, err := ParseAmount(test.value, l, c)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("got the error [%v], want [%v]", err, test.err)
+			}
+
+			if test.err == nil && got.String() != test.want {
+				t.Errorf("got [%s], want [%s]", got, test.want)
+			}
+		})
+	}
+}
+
+func TestFormatParsesBack(t *testing.T) {
+	tests := []struct {
+		tag      string
+		currency string
+		amount   string
+	}{
+		{tag: "en-US", currency: "USD", amount: "1234567.89"},
+		{tag: "de-DE", currency: "EUR", amount: "1234567.89"},
+		{tag: "fr-FR", currency: "EUR", amount: "1234567.89"},
+		{tag: "en-IN", currency: "INR", amount: "12345678.9"},
+		{tag: "ja-JP", currency: "JPY", amount: "1234567"},
+		{tag: "en-US", currency: "JPY", amount: "1234"},
+		{tag: "de-DE", currency: "USD", amount: "0.05"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.tag+" "+test.currency+" "+test.amount, func(t *testing.T) {
+			l, c := lookup(t, test.tag, test.currency)
+
+			amount, err := converter.ParseDecimal(test.amount)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			formatted := Format(amount, l, c)
+
+			parsed, err := ParseAmount(formatted, l, c)
+			if err != nil {
+				t.Fatalf("failed to parse back [%s]: %v", formatted, err)
+			}
+
+			if parsed.Cmp(amount) != 0 {
+				t.Errorf("got [%s] back from [%s], want [%s]", parsed, formatted, test.amount)
+			}
+		})
+	}
+}
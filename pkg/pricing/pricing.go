@@ -0,0 +1,293 @@
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"currency-converter/internal/exchange"
+	"currency-converter/pkg/converter"
+	"currency-converter/pkg/currency"
+)
+
+// AnyCurrency matches all the currencies in the From and To of a Rule.
+const AnyCurrency = "*"
+
+var (
+	// ErrAmountBelowFees is returned when an amount does not cover the fees of its conversion.
+	ErrAmountBelowFees = errors.New("amount does not cover the fees")
+
+	hundred = converter.NewDecimal(100, 0)
+	one     = converter.NewDecimal(1, 0)
+)
+
+// Rule is the margin charged on the conversions it matches, on top of the mid-market rate.
+// The fees are amounts of the source currency of the conversion, only a rule with a specific From can charge them.
+type Rule struct {
+	// Provider restricts the rule to the conversions with an exchange provider. Empty matches all of them.
+	Provider exchange.ProviderType `yaml:"provider"`
+
+	// From restricts the rule to a source currency. Empty or AnyCurrency matches all of them.
+	From string `yaml:"from"`
+
+	// To restricts the rule to a target currency. Empty or AnyCurrency matches all of them.
+	To string `yaml:"to"`
+
+	// SpreadPercent is taken off the mid-market rate, e.g. 0.5 applies 99.5% of the rate.
+	SpreadPercent converter.Decimal `yaml:"spreadPercent"`
+
+	// FixedFee is deducted from the amount before it is converted.
+	FixedFee converter.Decimal `yaml:"fixedFee"`
+
+	// MinimumFee is the least charged for a conversion, spread included. The fee is raised to reach it.
+	MinimumFee converter.Decimal `yaml:"minimumFee"`
+}
+
+// Validate returns an error describing the first invalid value of the rule.
+func (rule Rule) Validate() error {
+	if rule.Provider != "" && !exchange.IsSupportedProvider(rule.Provider) {
+		return fmt.Errorf("provider [%s] is not supported", rule.Provider)
+	}
+
+	for _, code := range []string{rule.From, rule.To} {
+		if code != "" && code != AnyCurrency && !currency.IsKnown(code) {
+			return fmt.Errorf("[%s] is not a known currency", code)
+		}
+	}
+
+	if rule.SpreadPercent.Sign() < 0 || rule.SpreadPercent.Cmp(hundred) >= 0 {
+		return fmt.Errorf("spreadPercent must be at least 0 and less than 100, got [%s]", rule.SpreadPercent)
+	}
+
+	if rule.FixedFee.Sign() < 0 || rule.MinimumFee.Sign() < 0 {
+		return fmt.Errorf("fees must not be negative")
+	}
+
+	// the fees are amounts of the source currency, which a rule matching all of them does not name.
+	if (rule.FixedFee.Sign() > 0 || rule.MinimumFee.Sign() > 0) && (rule.From == "" || rule.From == AnyCurrency) {
+		return fmt.Errorf("fees are amounts of the source currency, they require a specific from currency")
+	}
+
+	return nil
+}
+
+// matches returns true if the rule applies to the conversion.
+func (rule Rule) matches(provider exchange.ProviderType, from, to string) bool {
+	return (rule.Provider == "" || rule.Provider == provider) &&
+		matchesCurrency(rule.From, from) &&
+		matchesCurrency(rule.To, to)
+}
+
+func matchesCurrency(ruleCode, code string) bool {
+	return ruleCode == "" || ruleCode == AnyCurrency || strings.EqualFold(ruleCode, code)
+}
+
+// specificity returns the number of restrictions of the rule, the most specific rule wins.
+func (rule Rule) specificity() int {
+	n := 0
+	for _, restricted := range []bool{
+		rule.Provider != "",
+		rule.From != "" && rule.From != AnyCurrency,
+		rule.To != "" && rule.To != AnyCurrency,
+	} {
+		if restricted {
+			n++
+		}
+	}
+
+	return n
+}
+
+// Select returns the most specific rule matching the conversion, the first one listed among equally specific rules.
+func Select(rules []Rule, provider exchange.ProviderType, from, to string) (Rule, bool) {
+	best, found := Rule{}, false
+	for _, rule := range rules {
+		if rule.matches(provider, from, to) && (!found || rule.specificity() > best.specificity()) {
+			best, found = rule, true
+		}
+	}
+
+	return best, found
+}
+
+// Price is the breakdown of a conversion priced with a rule. All the amounts are of the source currency.
+type Price struct {
+	// MidRate is the mid-market rate, as derived from the exchange rates.
+	MidRate converter.Decimal
+
+	// AppliedRate is the rate the net amount is converted with, the spread taken off.
+	AppliedRate converter.Decimal
+
+	// SpreadPercent is the spread of the rule.
+	SpreadPercent converter.Decimal
+
+	// Amount is the amount paid, fee included.
+	Amount converter.Decimal
+
+	// SpreadAmount is the part of the net amount kept by the spread.
+	SpreadAmount converter.Decimal
+
+	// Fee is deducted from the amount before it is converted.
+	Fee converter.Decimal
+
+	// Net is the amount converted with the applied rate, i.e. Amount - Fee.
+	Net converter.Decimal
+}
+
+// Forward prices the conversion of the amount of the source currency with the mid-market rate.
+// The spread is charged on the net amount, the one converted once the fee is deducted.
+func (rule Rule) Forward(amount, midRate converter.Decimal, from currency.Currency) (Price, error) {
+	price, err := rule.price(midRate)
+	if err != nil {
+		return Price{}, err
+	}
+
+	price.Amount = amount
+	price.Fee = rule.FixedFee.Round(from.MinorUnits, converter.Ceiling)
+
+	if price.Net, err = amount.Sub(price.Fee); err != nil {
+		return Price{}, err
+	}
+
+	if price.SpreadAmount, err = spreadOf(price.Net, rule.SpreadPercent, from); err != nil {
+		return Price{}, err
+	}
+
+	charged, err := price.Fee.Add(price.SpreadAmount)
+	if err != nil {
+		return Price{}, err
+	}
+
+	if charged.Cmp(rule.MinimumFee) < 0 {
+		// the fee is raised so that the fee and the spread of the net amount pay the minimum fee:
+		// fee + spread * (amount - fee) = minimum, i.e. fee = (minimum - spread * amount) / (1 - spread).
+		if price.Fee, err = forwardMinimum(amount, rule, from); err != nil {
+			return Price{}, err
+		}
+
+		if price.Net, err = amount.Sub(price.Fee); err != nil {
+			return Price{}, err
+		}
+
+		if price.SpreadAmount, err = spreadOf(price.Net, rule.SpreadPercent, from); err != nil {
+			return Price{}, err
+		}
+	}
+
+	if price.Net.Sign() <= 0 {
+		return Price{}, ErrAmountBelowFees
+	}
+
+	return price, nil
+}
+
+// Reverse prices the conversion receiving the exact target amount with the mid-market rate.
+// The amount to be paid is rounded up to the minor units of the source currency.
+func (rule Rule) Reverse(target, midRate converter.Decimal, from currency.Currency) (Price, error) {
+	price, err := rule.price(midRate)
+	if err != nil {
+		return Price{}, err
+	}
+
+	if price.Net, err = target.Quo(price.AppliedRate, from.MinorUnits, converter.Ceiling); err != nil {
+		return Price{}, err
+	}
+
+	if price.SpreadAmount, err = spreadOf(price.Net, rule.SpreadPercent, from); err != nil {
+		return Price{}, err
+	}
+
+	price.Fee = rule.FixedFee.Round(from.MinorUnits, converter.Ceiling)
+
+	charged, err := price.Fee.Add(price.SpreadAmount)
+	if err != nil {
+		return Price{}, err
+	}
+
+	if charged.Cmp(rule.MinimumFee) < 0 {
+		// the fee and the spread of the net amount pay the minimum fee.
+		if price.Fee, err = rule.MinimumFee.Sub(price.SpreadAmount); err != nil {
+			return Price{}, err
+		}
+
+		price.Fee = price.Fee.Round(from.MinorUnits, converter.Ceiling)
+	}
+
+	if price.Amount, err = price.Net.Add(price.Fee); err != nil {
+		return Price{}, err
+	}
+
+	return price, nil
+}
+
+// forwardMinimum returns the fee which, with the spread of the rest of the amount, pays the minimum fee,
+// rounded up to the minor units of the currency.
+func forwardMinimum(amount converter.Decimal, rule Rule, from currency.Currency) (converter.Decimal, error) {
+	spread, err := rule.SpreadPercent.Quo(hundred, rule.SpreadPercent.Scale()+2, converter.HalfEven)
+	if err != nil {
+		return converter.Decimal{}, err
+	}
+
+	spreadAmount, err := amount.Mul(spread)
+	if err != nil {
+		return converter.Decimal{}, err
+	}
+
+	short, err := rule.MinimumFee.Sub(spreadAmount)
+	if err != nil {
+		return converter.Decimal{}, err
+	}
+
+	kept, err := one.Sub(spread)
+	if err != nil {
+		return converter.Decimal{}, err
+	}
+
+	return short.Quo(kept, from.MinorUnits, converter.Ceiling)
+}
+
+// AppliedRate returns the rate the amounts are converted with, the spread of the rule taken off the mid-market rate.
+func (rule Rule) AppliedRate(midRate converter.Decimal) (converter.Decimal, error) {
+	price, err := rule.price(midRate)
+	if err != nil {
+		return converter.Decimal{}, err
+	}
+
+	return price.AppliedRate, nil
+}
+
+// price returns the price with the rates of the rule.
+func (rule Rule) price(midRate converter.Decimal) (Price, error) {
+	kept, err := hundred.Sub(rule.SpreadPercent)
+	if err != nil {
+		return Price{}, err
+	}
+
+	// dividing by 100 is exact with 2 more digits.
+	factor, err := kept.Quo(hundred, kept.Scale()+2, converter.HalfEven)
+	if err != nil {
+		return Price{}, err
+	}
+
+	applied, err := midRate.Mul(factor)
+	if err != nil {
+		return Price{}, err
+	}
+
+	return Price{MidRate: midRate, AppliedRate: applied.Trim(), SpreadPercent: rule.SpreadPercent}, nil
+}
+
+// spreadOf returns the part of the amount kept by the spread, rounded to the minor units of the currency.
+func spreadOf(amount, spreadPercent converter.Decimal, c currency.Currency) (converter.Decimal, error) {
+	spread, err := amount.Mul(spreadPercent)
+	if err != nil {
+		return converter.Decimal{}, err
+	}
+
+	spread, err = spread.Quo(hundred, spread.Scale()+2, converter.HalfEven)
+	if err != nil {
+		return converter.Decimal{}, err
+	}
+
+	return spread.Round(c.MinorUnits, converter.HalfEven), nil
+}
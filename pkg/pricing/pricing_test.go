@@ -0,0 +1,146 @@
+package pricing
+
+import (
+	"errors"
+	"testing"
+
+	"currency-converter/pkg/converter"
+	"currency-converter/pkg/currency"
+)
+
+func decimal(t *testing.T, value string) converter.Decimal {
+	t.Helper()
+
+	d, err := converter.ParseDecimal(value)
+	if err != nil {
+		t.Fatalf("failed to parse [%s]: %v", value, err)
+	}
+
+	return d
+}
+
+func usd(t *testing.T) currency.Currency {
+	t.Helper()
+
+	c, ok := currency.Lookup("USD")
+	if !ok {
+		t.Fatal("USD is not a known currency")
+	}
+
+	return c
+}
+
+func TestForward(t *testing.T) {
+	rule := func(spread, fixed, minimum string) Rule {
+		return Rule{SpreadPercent: decimal(t, spread), FixedFee: decimal(t, fixed), MinimumFee: decimal(t, minimum)}
+	}
+
+	tests := []struct {
+		name   string
+		rule   Rule
+		amount string
+
+		fee, spread, net string
+		err              error
+	}{
+		{name: "spread on the net", rule: rule("0.5", "2", "0"), amount: "200", fee: "2", spread: "0.99", net: "198"},
+		{name: "above the minimum", rule: rule("1", "1", "3"), amount: "1000", fee: "1", spread: "9.99", net: "999"},
+		{name: "minimum reached", rule: rule("1", "1", "3"), amount: "100", fee: "2.03", spread: "0.98", net: "97.97"},
+		{name: "minimum without spread", rule: rule("0", "1", "3"), amount: "100", fee: "3", spread: "0", net: "97"},
+		{name: "fixed fee rounded up", rule: rule("0", "0.001", "0"), amount: "10", fee: "0.01", spread: "0", net: "9.99"},
+		{name: "below the fees", rule: rule("1", "1", "3"), amount: "2", err: ErrAmountBelowFees},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			price, err := test.rule.Forward(decimal(t, test.amount), decimal(t, "1"), usd(t))
+			if !errors.Is(err, test.err) {
+				t.Fatalf("got the error [%v], want [%v]", err, test.err)
+			}
+
+			if test.err != nil {
+				return
+			}
+
+			for _, got := range []struct {
+				field     string
+				got, want converter.Decimal
+			}{
+				{"fee", price.Fee, decimal(t, test.fee)},
+				{"spread", price.SpreadAmount, decimal(t, test.spread)},
+				{"net", price.Net, decimal(t, test.net)},
+				{"amount", price.Amount, decimal(t, test.amount)},
+			} {
+				if got.got.Cmp(got.want) != 0 {
+					t.Errorf("got the %s [%s], want [%s]", got.field, got.got, got.want)
+				}
+			}
+		})
+	}
+}
+
+func TestReverseIsPricedAsForward(t *testing.T) {
+	rule := Rule{SpreadPercent: decimal(t, "1"), FixedFee: decimal(t, "1"), MinimumFee: decimal(t, "3")}
+
+	tests := []struct {
+		name   string
+		target string
+
+		amount, fee, spread string
+	}{
+		{name: "above the minimum", target: "990", amount: "1001", fee: "1", spread: "10"},
+		{name: "minimum reached", target: "99", amount: "102", fee: "2", spread: "1"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reverse, err := rule.Reverse(decimal(t, test.target), decimal(t, "1"), usd(t))
+			if err != nil {
+				t.Fatalf("got the error [%v]", err)
+			}
+
+			if reverse.Amount.Cmp(decimal(t, test.amount)) != 0 || reverse.Fee.Cmp(decimal(t, test.fee)) != 0 ||
+				reverse.SpreadAmount.Cmp(decimal(t, test.spread)) != 0 {
+				t.Fatalf("got the amount [%s], fee [%s] and spread [%s], want [%s], [%s] and [%s]",
+					reverse.Amount, reverse.Fee, reverse.SpreadAmount, test.amount, test.fee, test.spread)
+			}
+
+			forward, err := rule.Forward(reverse.Amount, decimal(t, "1"), usd(t))
+			if err != nil {
+				t.Fatalf("got the error [%v] pricing the amount forward", err)
+			}
+
+			if forward.Fee.Cmp(reverse.Fee) != 0 || forward.SpreadAmount.Cmp(reverse.SpreadAmount) != 0 ||
+				forward.Net.Cmp(reverse.Net) != 0 {
+				t.Errorf("got the fee [%s], spread [%s] and net [%s] forward, want [%s], [%s] and [%s] as reverse",
+					forward.Fee, forward.SpreadAmount, forward.Net, reverse.Fee, reverse.SpreadAmount, reverse.Net)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  Rule
+		valid bool
+	}{
+		{name: "spread on all the currencies", rule: Rule{From: AnyCurrency, SpreadPercent: decimal(t, "0.5")}, valid: true},
+		{name: "fees of a currency", rule: Rule{From: "USD", FixedFee: decimal(t, "0.30"), MinimumFee: decimal(t, "1")}, valid: true},
+		{name: "fixed fee without currency", rule: Rule{FixedFee: decimal(t, "0.30")}},
+		{name: "minimum fee on all the currencies", rule: Rule{From: AnyCurrency, To: "EUR", MinimumFee: decimal(t, "1")}},
+		{name: "zero fees on all the currencies", rule: Rule{From: AnyCurrency, FixedFee: decimal(t, "0")}, valid: true},
+		{name: "negative fee", rule: Rule{From: "USD", FixedFee: decimal(t, "-1")}},
+		{name: "spread of 100 percent", rule: Rule{SpreadPercent: decimal(t, "100")}},
+		{name: "unknown currency", rule: Rule{From: "ABC"}},
+		{name: "unsupported provider", rule: Rule{Provider: "bogus"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := test.rule.Validate(); (err == nil) != test.valid {
+				t.Errorf("got the error [%v], want valid %t", err, test.valid)
+			}
+		})
+	}
+}
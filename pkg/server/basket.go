@@ -0,0 +1,138 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "currency-converter/api/pb/v1alpha1/currencyconverter"
+	"currency-converter/internal/cache"
+	"currency-converter/internal/errors"
+	"currency-converter/pkg/converter"
+	"currency-converter/pkg/locale"
+	"currency-converter/pkg/pricing"
+)
+
+func (server *converterServer) ConvertBasket(
+	ctx context.Context,
+	request *pb.ConvertBasketRequest) (*pb.ConvertBasketResponse, error) {
+	// TODO: User authentication using ctx
+
+	maxBatchSize := server.settings.Get().Conversion.MaxBatchSize
+	switch {
+	case len(request.GetAmounts()) == 0:
+		return nil, errors.FieldViolationError("amounts", "must not be empty")
+	case len(request.GetAmounts()) > maxBatchSize:
+		return nil, errors.FieldViolationError("amounts", fmt.Sprintf("must not have more than %d amounts", maxBatchSize))
+	}
+
+	legs := make([]*conversion, len(request.GetAmounts()))
+	for i, amount := range request.GetAmounts() {
+		c, err := server.parseConversion(&pb.ConversionRequest{
+			From:         amount,
+			To:           request.GetTo(),
+			RoundingMode: request.GetRoundingMode(),
+			Locale:       request.GetLocale(),
+		}, pb.RoundingMode_ROUNDING_MODE_UNSPECIFIED)
+		if err != nil {
+			return nil, amountError(i, err)
+		}
+
+		legs[i] = c
+	}
+
+	// every leg is converted with the same rates, even if the cache is refreshed meanwhile.
+	exProvider, err := server.provider(request.GetExchangeProvider())
+	if err != nil {
+		return nil, err
+	}
+
+	tables, err := server.rateTables(exProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ConvertBasketResponse{
+		Legs:               make([]*pb.ConversionResponse, len(legs)),
+		RoundingMode:       legs[0].appliedRoundingMode,
+		ConversionDatetime: timestamppb.Now(),
+	}
+
+	// the exact converted amounts are summed, so that the total is rounded only once.
+	total := converter.NewDecimal(0, 0)
+	for i, c := range legs {
+		leg, converted, err := server.convertAtMidRate(c, tables)
+		if err != nil {
+			return nil, amountError(i, err)
+		}
+
+		if total, err = total.Add(converted); err != nil {
+			return nil, errors.AmountOverflowError
+		}
+
+		response.Legs[i] = leg
+
+		fetchedAt := leg.GetExchangeRateDatetime()
+		if response.ExchangeRateDatetime == nil || fetchedAt.AsTime().Before(response.ExchangeRateDatetime.AsTime()) {
+			response.ExchangeRateDatetime = fetchedAt
+		}
+	}
+
+	to := legs[0].to
+	rounded := total.Round(to.MinorUnits, legs[0].roundingMode)
+
+	response.Total = &pb.Currency{Code: to.Code, Value: rounded.StringFixed(to.MinorUnits)}
+	if legs[0].localized {
+		response.Total.FormattedValue = locale.Format(rounded, legs[0].locale, to)
+	}
+
+	return response, nil
+}
+
+// convertAtMidRate converts the leg of a basket with the mid-market rate of the tables.
+// The pricing rules are not applied to the legs: their fees are charged per conversion, which a basket is not.
+func (server *converterServer) convertAtMidRate(
+	c *conversion,
+	tables []*cache.Rates) (*pb.ConversionResponse, converter.Decimal, error) {
+	rate, err := server.exchangeRate(tables, c.from, c.to)
+	if err != nil {
+		return nil, converter.Decimal{}, err
+	}
+
+	converted, _, err := c.exactly(rate.rate, pricing.Rule{}, false)
+	if err != nil {
+		return nil, converter.Decimal{}, err
+	}
+
+	response := c.response(converted)
+
+	rate.describe(response)
+
+	return response, converted, nil
+}
+
+// amountError returns the error of the amount at the index of a basket, its field violations pointing at the amount
+// rather than at the conversion request it was converted as.
+func amountError(index int, err error) error {
+	for _, detail := range status.Convert(err).Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		if !ok || len(badRequest.GetFieldViolations()) == 0 {
+			continue
+		}
+
+		violation := badRequest.GetFieldViolations()[0]
+		if !strings.HasPrefix(violation.GetField(), "from.") {
+			continue
+		}
+
+		field := fmt.Sprintf("amounts[%d].%s", index, strings.TrimPrefix(violation.GetField(), "from."))
+
+		return errors.FieldViolationError(field, violation.GetDescription())
+	}
+
+	return err
+}
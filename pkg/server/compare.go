@@ -0,0 +1,187 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "currency-converter/api/pb/v1alpha1/currencyconverter"
+	"currency-converter/internal/cache"
+	"currency-converter/internal/errors"
+	"currency-converter/internal/exchange"
+	"currency-converter/pkg/converter"
+)
+
+// spreadBpsScale is the number of decimals of the spread in basis points.
+const spreadBpsScale = 2
+
+var (
+	two         = converter.NewDecimal(2, 0)
+	basisPoints = converter.NewDecimal(10000, 0)
+)
+
+func (server *converterServer) CompareConversion(
+	ctx context.Context,
+	request *pb.CompareConversionRequest) (*pb.CompareConversionResponse, error) {
+	// TODO: User authentication using ctx
+
+	if request.GetConversion() == nil {
+		return nil, errors.FieldViolationError("conversion", "must be set")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, server.settings.Get().Conversion.CompareTimeout)
+	defer cancel()
+
+	providers := exchange.GetSupportedProviders()
+	sort.Slice(providers, func(i, j int) bool {
+		return providers[i] < providers[j]
+	})
+
+	conversions := make([]*pb.ProviderConversion, len(providers))
+	errs := make([]error, len(providers))
+
+	wg := &sync.WaitGroup{}
+	for i, exProvider := range providers {
+		i, exProvider := i, exProvider
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			conversion := proto.Clone(request.GetConversion()).(*pb.ConversionRequest)
+			conversion.ExchangeProvider = string(exProvider)
+
+			response, err := server.convert(conversion, server.ratesBefore(ctx), pb.RoundingMode_ROUNDING_MODE_UNSPECIFIED)
+			conversions[i] = &pb.ProviderConversion{
+				ExchangeProvider: string(exProvider),
+				Conversion:       response,
+				Status:           status.Convert(err).Proto(),
+			}
+			errs[i] = err
+		}()
+	}
+
+	wg.Wait()
+
+	// an invalid conversion fails with every provider, it is rejected as a whole.
+	for i, err := range errs {
+		if !errors.IsInvalidArgument(err) {
+			break
+		}
+
+		if i == len(errs)-1 {
+			return nil, err
+		}
+	}
+
+	stats, err := rateStats(conversions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CompareConversionResponse{Conversions: conversions, Stats: stats}, nil
+}
+
+// ratesBefore returns the cached rates tables of the providers. The rates of a provider are refreshed when none is
+// cached, as long as they are refreshed before ctx is done.
+func (server *converterServer) ratesBefore(ctx context.Context) ratesSource {
+	return func(exProvider exchange.ProviderType) ([]*cache.Rates, error) {
+		tables, err := server.cachedRateTables(exProvider)
+		if err != nil || len(tables) > 0 {
+			return tables, err
+		}
+
+		// the refresh goes on after the deadline, for the next requests.
+		select {
+		case result := <-server.refresh(exProvider):
+			if result.Err != nil {
+				return nil, result.Err
+			}
+
+			return server.cachedRateTables(exProvider)
+
+		case <-ctx.Done():
+			return nil, status.Errorf(codes.DeadlineExceeded, "the rates of the provider [%s] were not fetched in time", exProvider)
+		}
+	}
+}
+
+// providerRate is the mid-market rate of a conversion of a provider.
+type providerRate struct {
+	exProvider string
+	rate       converter.Decimal
+}
+
+// rateStats returns the statistics of the mid-market rates of the conversions which succeeded, nil if none did.
+func rateStats(conversions []*pb.ProviderConversion) (*pb.ConversionRateStats, error) {
+	var rates []providerRate
+	for _, conversion := range conversions {
+		if conversion.GetConversion() == nil {
+			continue
+		}
+
+		// with a pricing rule, the rate of the conversion is the applied rate, the providers quote the mid rate.
+		value := conversion.GetConversion().GetExchangeRateValue()
+		if conversion.GetConversion().GetFees() != nil {
+			value = conversion.GetConversion().GetFees().GetMidRate()
+		}
+
+		rate, err := converter.ParseDecimal(value)
+		if err != nil {
+			return nil, errors.InvalidExchangeRateError(conversion.GetConversion().GetConverted().GetCode())
+		}
+
+		rates = append(rates, providerRate{exProvider: conversion.GetExchangeProvider(), rate: rate})
+	}
+
+	if len(rates) == 0 {
+		return nil, nil
+	}
+
+	sort.SliceStable(rates, func(i, j int) bool {
+		return rates[i].rate.Cmp(rates[j].rate) < 0
+	})
+
+	lowest, highest := rates[0], rates[len(rates)-1]
+
+	median := rates[len(rates)/2].rate
+	if len(rates)%2 == 0 {
+		sum, err := rates[len(rates)/2-1].rate.Add(median)
+		if err != nil {
+			return nil, errors.AmountOverflowError
+		}
+
+		// halving is exact with one more digit.
+		if median, err = sum.Quo(two, sum.Scale()+1, converter.HalfEven); err != nil {
+			return nil, errors.AmountOverflowError
+		}
+	}
+
+	spread, err := highest.rate.Sub(lowest.rate)
+	if err != nil {
+		return nil, errors.AmountOverflowError
+	}
+
+	if spread, err = spread.Mul(basisPoints); err != nil {
+		return nil, errors.AmountOverflowError
+	}
+
+	if spread, err = spread.Quo(median, spreadBpsScale, converter.HalfEven); err != nil {
+		return nil, errors.AmountOverflowError
+	}
+
+	return &pb.ConversionRateStats{
+		Count:       uint32(len(rates)),
+		MinRate:     lowest.rate.String(),
+		MinProvider: lowest.exProvider,
+		MaxRate:     highest.rate.String(),
+		MaxProvider: highest.exProvider,
+		MedianRate:  median.Trim().String(),
+		SpreadBps:   spread.String(),
+	}, nil
+}
@@ -0,0 +1,106 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "currency-converter/api/pb/v1alpha1/currencyconverter"
+	"currency-converter/internal/cache"
+	"currency-converter/internal/cache/inmemory"
+	"currency-converter/internal/config"
+	"currency-converter/internal/exchange"
+	"currency-converter/internal/health"
+)
+
+// slowStore is a store whose refreshes of the rates last until release is closed, counting them
+// and the reads of the rates tables.
+type slowStore struct {
+	cache.Store
+
+	refreshes int32
+	reads     int32
+	release   chan struct{}
+}
+
+func (store *slowStore) GetRates(exchangeProvider exchange.ProviderType, base string) (*cache.Rates, error) {
+	atomic.AddInt32(&store.reads, 1)
+	return store.Store.GetRates(exchangeProvider, base)
+}
+
+func (store *slowStore) RefreshExchangeRates([]exchange.ProviderType) error {
+	atomic.AddInt32(&store.refreshes, 1)
+	<-store.release
+
+	return nil
+}
+
+func TestRatesBeforeRefreshesOncePerProvider(t *testing.T) {
+	settings := config.NewHolder(config.Default())
+	store := &slowStore{Store: inmemory.NewStore(settings, health.NewMonitor(settings)), release: make(chan struct{})}
+	server := &converterServer{store: store, settings: settings, refreshes: &singleflight.Group{}}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			if _, err := server.ratesBefore(ctx)(exchange.Fixer); status.Code(err) != codes.DeadlineExceeded {
+				t.Errorf("got the error [%v], want DeadlineExceeded", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(store.release)
+
+	if refreshes := atomic.LoadInt32(&store.refreshes); refreshes != 1 {
+		t.Errorf("got %d refreshes, want 1", refreshes)
+	}
+}
+
+func TestConversionsMissingTheRatesRefreshThemOnce(t *testing.T) {
+	settings := config.NewHolder(config.Default())
+	store := &slowStore{Store: inmemory.NewStore(settings, health.NewMonitor(settings)), release: make(chan struct{})}
+	server := &converterServer{store: store, settings: settings, refreshes: &singleflight.Group{}}
+
+	const conversions = 10
+
+	var wg sync.WaitGroup
+	for i := 0; i < conversions; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			// the stub provider has no rates, the conversion fails once the rates are refreshed.
+			_, _ = server.Convert(context.Background(), &pb.ConversionRequest{
+				From:             &pb.Currency{Code: "USD", Value: "1"},
+				To:               "EUR",
+				ExchangeProvider: string(exchange.Fixer),
+			})
+		}()
+	}
+
+	// every conversion missed the cached tables, and waits for the refresh.
+	for atomic.LoadInt32(&store.reads) < int32(conversions*len(server.pivots())) {
+		time.Sleep(time.Millisecond)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	close(store.release)
+	wg.Wait()
+
+	if refreshes := atomic.LoadInt32(&store.refreshes); refreshes != 1 {
+		t.Errorf("got %d refreshes, want 1", refreshes)
+	}
+}
@@ -0,0 +1,130 @@
+package server
+
+import (
+	"io"
+	"sync"
+
+	"google.golang.org/grpc/status"
+
+	pb "currency-converter/api/pb/v1alpha1/currencyconverter"
+	"currency-converter/internal/errors"
+)
+
+func (server *converterServer) ConvertStream(stream pb.CurrencyConverterService_ConvertStreamServer) error {
+	// TODO: User authentication using ctx
+
+	ctx := stream.Context()
+
+	// the responses are sent by a single goroutine, in the order the conversions complete.
+	responses := make(chan *pb.ConvertStreamResponse)
+	sent := make(chan error, 1)
+
+	go func() {
+		var err error
+		for response := range responses {
+			if err == nil {
+				err = stream.Send(response)
+			}
+		}
+
+		sent <- err
+	}()
+
+	// the requests are received by a single goroutine, so that the stream can end on shutdown while it waits for one.
+	received := make(chan *pb.ConvertStreamRequest)
+	receiveErr := make(chan error, 1)
+	stopped := make(chan struct{})
+	defer close(stopped)
+
+	go func() {
+		for {
+			request, err := stream.Recv()
+			if err != nil {
+				receiveErr <- err
+				return
+			}
+
+			select {
+			case received <- request:
+			case <-stopped:
+				return
+			}
+		}
+	}()
+
+	// inFlight bounds the conversions converted at once, the stream is not read further while it is full.
+	inFlight := make(chan struct{}, server.settings.Get().Conversion.MaxStreamInFlight)
+	wg := &sync.WaitGroup{}
+
+	var err error
+
+receive:
+	for {
+		var request *pb.ConvertStreamRequest
+		select {
+		case request = <-received:
+		case err = <-receiveErr:
+			break receive
+		case <-ctx.Done():
+			err = ctx.Err()
+			break receive
+		case <-server.shutdown:
+			// the conversions in flight are still sent back, the client retries the others with another server.
+			err = errors.ShuttingDownError
+			break receive
+		}
+
+		select {
+		case inFlight <- struct{}{}:
+		case <-ctx.Done():
+			err = ctx.Err()
+			break receive
+		case <-server.shutdown:
+			err = errors.ShuttingDownError
+			break receive
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-inFlight
+				wg.Done()
+			}()
+
+			responses <- server.convertStreamed(request)
+		}()
+	}
+
+	wg.Wait()
+	close(responses)
+
+	sendErr := <-sent
+
+	if err != io.EOF {
+		return err
+	}
+
+	return sendErr
+}
+
+// convertStreamed converts the request of a stream. A failed conversion has its status in the response,
+// the stream goes on.
+func (server *converterServer) convertStreamed(request *pb.ConvertStreamRequest) *pb.ConvertStreamResponse {
+	response := &pb.ConvertStreamResponse{Id: request.GetId()}
+
+	var err error
+	switch {
+	case request.GetId() == "":
+		err = errors.FieldViolationError("id", "must be set")
+	case request.GetConversion() == nil:
+		err = errors.FieldViolationError("conversion", "must be set")
+	default:
+		response.Conversion, err = server.convert(
+			request.GetConversion(), server.rateTables, pb.RoundingMode_ROUNDING_MODE_UNSPECIFIED)
+	}
+
+	response.Status = status.Convert(err).Proto()
+
+	return response
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/status"
+
+	pb "currency-converter/api/pb/v1alpha1/currencyconverter"
+	"currency-converter/internal/cache"
+	"currency-converter/internal/errors"
+	"currency-converter/internal/exchange"
+	"currency-converter/pkg/currency"
+)
+
+func (server *converterServer) ConvertToMany(
+	ctx context.Context,
+	request *pb.ConvertToManyRequest) (*pb.ConvertToManyResponse, error) {
+	// TODO: User authentication using ctx
+
+	maxBatchSize := server.settings.Get().Conversion.MaxBatchSize
+	switch {
+	case len(request.GetTo()) == 0:
+		return nil, errors.FieldViolationError("to", "must not be empty")
+	case len(request.GetTo()) > maxBatchSize:
+		return nil, errors.FieldViolationError("to", fmt.Sprintf("must not have more than %d currencies", maxBatchSize))
+	}
+
+	// the source amount is the same for every currency, it is rejected as a whole.
+	source, err := server.parseSource(
+		request.GetFrom(), request.GetLocale(), request.GetRoundingMode(), pb.RoundingMode_ROUNDING_MODE_UNSPECIFIED)
+	if err != nil {
+		return nil, err
+	}
+
+	exProvider, err := server.provider(request.GetExchangeProvider())
+	if err != nil {
+		return nil, err
+	}
+
+	// the rates are read once, every currency is converted with the same rates.
+	tables, err := server.rateTables(exProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	rates := func(exchange.ProviderType) ([]*cache.Rates, error) {
+		return tables, nil
+	}
+
+	response := &pb.ConvertToManyResponse{Results: make([]*pb.BatchConversionResult, len(request.GetTo()))}
+	for i, code := range request.GetTo() {
+		var conversion *pb.ConversionResponse
+
+		to, present := currency.Lookup(code)
+		if !present {
+			err = errors.UnknownCurrencyError(code)
+		} else {
+			c := *source
+			c.to = to
+
+			conversion, _, err = server.convertParsed(&c, exProvider, rates)
+		}
+
+		response.Results[i] = &pb.BatchConversionResult{Conversion: conversion, Status: status.Convert(err).Proto()}
+	}
+
+	return response, nil
+}
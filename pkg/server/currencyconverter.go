@@ -3,66 +3,508 @@ package server
 import (
 	"context"
 	"fmt"
-	"strconv"
+	"runtime"
+	"sort"
+	"sync"
 
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "currency-converter/api/pb/v1alpha1/currencyconverter"
 	"currency-converter/internal/cache"
+	"currency-converter/internal/config"
 	"currency-converter/internal/errors"
 	"currency-converter/internal/exchange"
+	"currency-converter/internal/health"
+	"currency-converter/internal/history"
+	"currency-converter/internal/watch"
 	"currency-converter/pkg/converter"
+	"currency-converter/pkg/currency"
+	"currency-converter/pkg/locale"
+	"currency-converter/pkg/pricing"
 )
 
 type converterServer struct {
 	store cache.Store
+
+	// quotes holds the exchange rates locked for the clients.
+	quotes cache.QuoteStore
+
+	// history holds the rates of the past refreshes.
+	history history.Store
+
+	// watch notifies the watches of the exchange rates of the rates set in the store.
+	watch *watch.Hub
+
+	// monitor holds the reachability of the exchange providers.
+	monitor *health.Monitor
+
+	// settings holds the default provider, used when a request does not specify the exchange provider.
+	settings *config.Holder
+
+	// shutdown is closed once the server shuts down, the streams end then so that the servers can drain.
+	shutdown <-chan struct{}
+
+	// refreshes holds the refreshes of the rates started by the requests, a single one per provider.
+	refreshes *singleflight.Group
 }
 
-func NewServer(store cache.Store) pb.CurrencyConverterServiceServer {
+func NewServer(
+	store cache.Store,
+	quotes cache.QuoteStore,
+	history history.Store,
+	watch *watch.Hub,
+	monitor *health.Monitor,
+	settings *config.Holder,
+	shutdown <-chan struct{}) pb.CurrencyConverterServiceServer {
 	return &converterServer{
-		store: store,
+		store:     store,
+		quotes:    quotes,
+		history:   history,
+		watch:     watch,
+		monitor:   monitor,
+		settings:  settings,
+		shutdown:  shutdown,
+		refreshes: &singleflight.Group{},
 	}
 }
 
-func (server *converterServer) ListExchangeRates(
-	ctx context.Context,
-	request *pb.ListExchangeRatesRequest) (*pb.ListExchangeRatesResponse, error) {
-	return nil, errors.UnImplementedError
-}
-
 func (server *converterServer) Convert(ctx context.Context, request *pb.ConversionRequest) (*pb.ConversionResponse, error) {
 	// TODO: User authentication using ctx
 
-	exProvider := exchange.ProviderType(request.ExchangeProvider)
-	if exProvider == "" {
-		exProvider = exchange.CurrencyLayer
+	return server.convert(request, server.rateTables, pb.RoundingMode_ROUNDING_MODE_UNSPECIFIED)
+}
+
+// ratesSource returns the rates tables of an exchange provider.
+type ratesSource func(exProvider exchange.ProviderType) ([]*cache.Rates, error)
+
+// convert converts the request with the rates tables of the source.
+// fallbackRoundingMode applies when the request does not specify the rounding mode.
+func (server *converterServer) convert(
+	request *pb.ConversionRequest,
+	rates ratesSource,
+	fallbackRoundingMode pb.RoundingMode) (*pb.ConversionResponse, error) {
+	c, err := server.parseConversion(request, fallbackRoundingMode)
+	if err != nil {
+		return nil, err
+	}
+
+	exProvider, err := server.provider(request.GetExchangeProvider())
+	if err != nil {
+		return nil, err
 	}
 
-	var rate float32
-	var err error
+	response, _, err := server.convertParsed(c, exProvider, rates)
+
+	return response, err
+}
+
+// convertParsed converts the validated conversion with the rates tables of the provider from the source.
+// The converted amount is also returned exactly, before its rounding.
+func (server *converterServer) convertParsed(
+	c *conversion,
+	exProvider exchange.ProviderType,
+	rates ratesSource) (*pb.ConversionResponse, converter.Decimal, error) {
+	tables, err := rates(exProvider)
+	if err != nil {
+		return nil, converter.Decimal{}, err
+	}
 
-	if rate, err = server.store.GetExchangeRate(request.GetTo(), exProvider); err != nil {
+	rate, err := server.exchangeRate(tables, c.from, c.to)
+	if err != nil {
+		return nil, converter.Decimal{}, err
+	}
+
+	rule, priced := server.pricingRule(exProvider, c.from, c.to)
+
+	converted, price, err := c.exactly(rate.rate, rule, priced)
+	if err != nil {
+		return nil, converter.Decimal{}, err
+	}
+
+	response := c.response(converted)
+
+	rate.describe(response)
+
+	if price != nil {
+		describePrice(response, *price, c.from)
+	}
+
+	return response, converted, nil
+}
+
+// conversion is a validated conversion request.
+type conversion struct {
+	from, to currency.Currency
+	amount   converter.Decimal
+
+	// locale is the locale of the amount, the converted value is also formatted in it when localized.
+	locale    locale.Locale
+	localized bool
+
+	roundingMode        converter.RoundingMode
+	appliedRoundingMode pb.RoundingMode
+}
+
+// parseConversion validates the request.
+// fallbackRoundingMode applies when the request does not specify the rounding mode.
+func (server *converterServer) parseConversion(
+	request *pb.ConversionRequest,
+	fallbackRoundingMode pb.RoundingMode) (*conversion, error) {
+	c, err := server.parseSource(request.GetFrom(), request.GetLocale(), request.GetRoundingMode(), fallbackRoundingMode)
+	if err != nil {
 		return nil, err
 	}
 
-	// cache HIT
-	// Handle error better. Implemented just for the submission purpose.
-	amount, _ := strconv.ParseFloat(request.GetFrom().Value, 64)
+	to, present := currency.Lookup(request.GetTo())
+	if !present {
+		return nil, errors.UnknownCurrencyError(request.GetTo())
+	}
+
+	c.to = to
 
-	return &pb.ConversionResponse{
+	return c, nil
+}
+
+// parseSource validates the source amount of a conversion, written in the locale, the target currency is left to
+// the caller. fallbackRoundingMode applies when the requested rounding mode is unspecified.
+func (server *converterServer) parseSource(
+	from *pb.Currency,
+	requestedLocale string,
+	requestedRoundingMode pb.RoundingMode,
+	fallbackRoundingMode pb.RoundingMode) (*conversion, error) {
+	fromCurrency, present := currency.Lookup(from.GetCode())
+	if !present {
+		return nil, errors.UnknownCurrencyError(from.GetCode())
+	}
+
+	amountLocale, present := locale.Lookup(requestedLocale)
+	if !present {
+		return nil, errors.FieldViolationError("locale", fmt.Sprintf("unsupported locale [%s]", requestedLocale))
+	}
+
+	amount, err := locale.ParseAmount(from.GetValue(), amountLocale, fromCurrency)
+	if err != nil {
+		return nil, errors.FieldViolationError("from.value", err.Error())
+	}
+
+	roundingMode, appliedRoundingMode, err := server.roundingMode(requestedRoundingMode, fallbackRoundingMode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &conversion{
+		from:                fromCurrency,
+		amount:              amount,
+		locale:              amountLocale,
+		localized:           requestedLocale != "",
+		roundingMode:        roundingMode,
+		appliedRoundingMode: appliedRoundingMode,
+	}, nil
+}
+
+// at converts the amount with the mid-market rate, priced with the rule when priced.
+// The exchange rate of the response is left to the caller, the price is returned when priced.
+func (c *conversion) at(
+	midRate converter.Decimal,
+	rule pricing.Rule,
+	priced bool) (*pb.ConversionResponse, *pricing.Price, error) {
+	converted, price, err := c.exactly(midRate, rule, priced)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return c.response(converted), price, nil
+}
+
+// exactly returns the amount converted with the mid-market rate, priced with the rule when priced, before rounding.
+func (c *conversion) exactly(
+	midRate converter.Decimal,
+	rule pricing.Rule,
+	priced bool) (converter.Decimal, *pricing.Price, error) {
+	appliedRate, net := midRate, c.amount
+
+	var price *pricing.Price
+	if priced {
+		forward, err := rule.Forward(c.amount, midRate, c.from)
+		if err != nil {
+			return converter.Decimal{}, nil, pricingError("from.value", err)
+		}
+
+		price = &forward
+		appliedRate, net = price.AppliedRate, price.Net
+	}
+
+	converted, err := converter.Convert(appliedRate, net)
+	if err != nil {
+		return converter.Decimal{}, nil, errors.AmountOverflowError
+	}
+
+	return converted, price, nil
+}
+
+// response returns the response of the conversion with the converted amount, rounded to the minor units of the
+// target currency. The exchange rate is left to the caller.
+func (c *conversion) response(converted converter.Decimal) *pb.ConversionResponse {
+	rounded := converted.Round(c.to.MinorUnits, c.roundingMode)
+
+	response := &pb.ConversionResponse{
 		Converted: &pb.Currency{
-			Code:  request.GetTo(),
-			Value: fmt.Sprintf("%.2f", converter.Convert(rate, amount)),
+			Code:  c.to.Code,
+			Value: rounded.StringFixed(c.to.MinorUnits),
 		},
-		From:                 request.GetFrom(),
-		ExchangeRate:         rate,
-		ConversionDatetime:   timestamppb.Now(),
-		ExchangeRateDatetime: timestamppb.Now(),
-	}, nil
+		From:               &pb.Currency{Code: c.from.Code, Value: c.amount.String()},
+		RoundingMode:       c.appliedRoundingMode,
+		ConversionDatetime: timestamppb.Now(),
+	}
+
+	if c.localized {
+		response.Converted.FormattedValue = locale.Format(rounded, c.locale, c.to)
+	}
+
+	return response
+}
+
+func (server *converterServer) ReverseConvert(
+	ctx context.Context,
+	request *pb.ReverseConversionRequest) (*pb.ConversionResponse, error) {
+	// TODO: User authentication using ctx
+
+	from, present := currency.Lookup(request.GetFrom())
+	if !present {
+		return nil, errors.UnknownCurrencyError(request.GetFrom())
+	}
+
+	to, present := currency.Lookup(request.GetTo().GetCode())
+	if !present {
+		return nil, errors.UnknownCurrencyError(request.GetTo().GetCode())
+	}
+
+	amountLocale, present := locale.Lookup(request.GetLocale())
+	if !present {
+		return nil, errors.FieldViolationError("locale", fmt.Sprintf("unsupported locale [%s]", request.GetLocale()))
+	}
+
+	target, err := locale.ParseAmount(request.GetTo().GetValue(), amountLocale, to)
+	if err != nil {
+		return nil, errors.FieldViolationError("to.value", err.Error())
+	}
+
+	exProvider, err := server.provider(request.GetExchangeProvider())
+	if err != nil {
+		return nil, err
+	}
+
+	tables, err := server.rateTables(exProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	rate, err := server.exchangeRate(tables, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, priced := server.pricingRule(exProvider, from, to)
+
+	var source converter.Decimal
+	var price pricing.Price
+	if priced {
+		if price, err = rule.Reverse(target, rate.rate, from); err != nil {
+			return nil, pricingError("to.value", err)
+		}
+
+		source = price.Amount
+	} else if source, err = target.Quo(rate.rate, from.MinorUnits, converter.Ceiling); err != nil {
+		// the source amount is rounded up, so that converting it back never gives less than the target amount.
+		return nil, errors.AmountOverflowError
+	}
+
+	response := &pb.ConversionResponse{
+		Converted:          &pb.Currency{Code: to.Code, Value: target.StringFixed(to.MinorUnits)},
+		From:               &pb.Currency{Code: from.Code, Value: source.StringFixed(from.MinorUnits)},
+		RoundingMode:       pb.RoundingMode_ROUNDING_MODE_CEILING,
+		ConversionDatetime: timestamppb.Now(),
+	}
+
+	if request.GetLocale() != "" {
+		response.From.FormattedValue = locale.Format(source, amountLocale, from)
+		response.Converted.FormattedValue = locale.Format(target, amountLocale, to)
+	}
+
+	rate.describe(response)
+
+	if priced {
+		describePrice(response, price, from)
+	}
+
+	return response, nil
+}
+
+// provider returns the requested exchange provider, or the default provider of the deployment.
+// returns InvalidArgument error if the requested provider is not supported.
+func (server *converterServer) provider(requested string) (exchange.ProviderType, error) {
+	if requested == "" {
+		return server.settings.Get().Exchange.DefaultProvider, nil
+	}
+
+	if !exchange.IsSupportedProvider(exchange.ProviderType(requested)) {
+		return "", errors.FieldViolationError("exchange_provider", fmt.Sprintf("unsupported provider [%s]", requested))
+	}
+
+	return exchange.ProviderType(requested), nil
+}
+
+// pivots returns the rank of every configured pivot currency. exchange.BaseCurrency is always a pivot.
+func (server *converterServer) pivots() map[string]int {
+	pivots := map[string]int{exchange.BaseCurrency: 0}
+	for _, code := range server.settings.Get().Conversion.Pivots {
+		if _, present := pivots[code]; !present {
+			pivots[code] = len(pivots)
+		}
+	}
+
+	return pivots
+}
+
+// rateTables returns the cached rates tables of the provider, against exchange.BaseCurrency and the pivot currencies.
+// The rates of the provider are refreshed when none is cached.
+func (server *converterServer) rateTables(exProvider exchange.ProviderType) ([]*cache.Rates, error) {
+	tables, err := server.cachedRateTables(exProvider)
+	if err != nil || len(tables) > 0 {
+		return tables, err
+	}
+
+	// cache MISS: refresh the rates of the provider
+	if result := <-server.refresh(exProvider); result.Err != nil {
+		return nil, result.Err
+	}
+
+	return server.cachedRateTables(exProvider)
+}
+
+// refresh refreshes the rates of the provider, the requests refreshing them meanwhile share the same refresh.
+func (server *converterServer) refresh(exProvider exchange.ProviderType) <-chan singleflight.Result {
+	return server.refreshes.DoChan(string(exProvider), func() (interface{}, error) {
+		return nil, server.store.RefreshExchangeRates([]exchange.ProviderType{exProvider})
+	})
+}
+
+func (server *converterServer) cachedRateTables(exProvider exchange.ProviderType) ([]*cache.Rates, error) {
+	pivots := server.pivots()
+
+	bases := make([]string, 0, len(pivots))
+	for code := range pivots {
+		bases = append(bases, code)
+	}
+
+	sort.Slice(bases, func(i, j int) bool {
+		return pivots[bases[i]] < pivots[bases[j]]
+	})
+
+	var tables []*cache.Rates
+	for _, base := range bases {
+		rates, err := server.store.GetRates(exProvider, base)
+		if errors.IsNotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		tables = append(tables, rates)
+	}
+
+	return tables, nil
+}
+
+// tableRate returns the rate of the currency in the rates table, the base currency itself having the rate 1.
+func tableRate(table *cache.Rates, code string) converter.Decimal {
+	if code == table.Base {
+		return converter.NewDecimal(1, 0)
+	}
+
+	return converter.NewFromFloat32(table.Values[code])
 }
 
 func (server *converterServer) BatchConvert(
-	_ context.Context,
-	_ *pb.BatchConversionRequest) (*pb.BatchConversionResponse, error) {
-	return nil, errors.UnImplementedError
+	ctx context.Context,
+	request *pb.BatchConversionRequest) (*pb.BatchConversionResponse, error) {
+	// TODO: User authentication using ctx
+
+	maxBatchSize := uint64(server.settings.Get().Conversion.MaxBatchSize)
+	if request.GetBatchLimit() > maxBatchSize {
+		return nil, errors.FieldViolationError("batch_limit", fmt.Sprintf("must not be more than %d", maxBatchSize))
+	}
+
+	limit := maxBatchSize
+	if request.GetBatchLimit() > 0 {
+		limit = request.GetBatchLimit()
+	}
+
+	switch {
+	case len(request.GetCurrencies()) == 0:
+		return nil, errors.FieldViolationError("currencies", "must not be empty")
+	case uint64(len(request.GetCurrencies())) > limit:
+		return nil, errors.FieldViolationError("currencies", fmt.Sprintf("must not have more than %d conversions", limit))
+	}
+
+	rates := server.snapshot(request.GetCurrencies())
+
+	results := make([]*pb.BatchConversionResult, len(request.GetCurrencies()))
+
+	// the conversions only compute from then on, they run on as many goroutines as CPUs.
+	sem := make(chan struct{}, runtime.GOMAXPROCS(0))
+	wg := &sync.WaitGroup{}
+
+	for i, conversion := range request.GetCurrencies() {
+		i, conversion := i, conversion
+
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			response, err := server.convert(conversion, rates, request.GetRoundingMode())
+			results[i] = &pb.BatchConversionResult{Conversion: response, Status: status.Convert(err).Proto()}
+		}()
+	}
+
+	wg.Wait()
+
+	return &pb.BatchConversionResponse{Results: results}, nil
+}
+
+// snapshot reads once the rates tables of every exchange provider of the conversions,
+// so that all of them are converted with the same rates, even if the cache is refreshed meanwhile.
+func (server *converterServer) snapshot(conversions []*pb.ConversionRequest) ratesSource {
+	type entry struct {
+		tables []*cache.Rates
+		err    error
+	}
+
+	entries := map[exchange.ProviderType]entry{}
+	for _, conversion := range conversions {
+		exProvider, err := server.provider(conversion.GetExchangeProvider())
+		if err != nil {
+			// the conversion is rejected on its own, before reading any rates.
+			continue
+		}
+
+		if _, present := entries[exProvider]; !present {
+			tables, err := server.rateTables(exProvider)
+			entries[exProvider] = entry{tables: tables, err: err}
+		}
+	}
+
+	return func(exProvider exchange.ProviderType) ([]*cache.Rates, error) {
+		e := entries[exProvider]
+		return e.tables, e.err
+	}
 }
@@ -0,0 +1,130 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+
+	pb "currency-converter/api/pb/v1alpha1/currencyconverter"
+	"currency-converter/internal/cache"
+	"currency-converter/internal/cache/inmemory"
+	"currency-converter/internal/config"
+	"currency-converter/internal/health"
+	"currency-converter/internal/history/file"
+	"currency-converter/internal/watch"
+	"currency-converter/pkg/converter"
+	"currency-converter/pkg/pricing"
+)
+
+// newRatedTestServer returns a server with the rates against USD cached for the default provider.
+func newRatedTestServer(t *testing.T, values map[string]float32) pb.CurrencyConverterServiceServer {
+	t.Helper()
+
+	return newPricedTestServer(t, values, nil)
+}
+
+// newPricedTestServer returns a server with the rates against USD cached for the default provider,
+// pricing the conversions with the rules.
+func newPricedTestServer(
+	t *testing.T,
+	values map[string]float32,
+	rules []pricing.Rule) pb.CurrencyConverterServiceServer {
+	t.Helper()
+
+	cfg := config.Default()
+	cfg.History.Dir = t.TempDir()
+	cfg.Pricing.Rules = rules
+
+	settings := config.NewHolder(cfg)
+	monitor := health.NewMonitor(settings)
+	store := inmemory.NewStore(settings, monitor)
+
+	rates := &cache.Rates{Base: "USD", Values: values, FetchedAt: time.Now()}
+	if err := store.SetRates(cfg.Exchange.DefaultProvider, rates, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+
+	return NewServer(store, inmemory.NewQuoteStore(), file.NewStore(settings), watch.NewHub(), monitor, settings,
+		make(chan struct{}))
+}
+
+func TestReverseConvertFixesTheTargetToItsMinorUnits(t *testing.T) {
+	server := newRatedTestServer(t, map[string]float32{"EUR": 0.8, "JPY": 150})
+
+	tests := []struct {
+		to   *pb.Currency
+		want string
+	}{
+		{to: &pb.Currency{Code: "EUR", Value: "500"}, want: "500.00"},
+		{to: &pb.Currency{Code: "EUR", Value: "500.5"}, want: "500.50"},
+		{to: &pb.Currency{Code: "JPY", Value: "500"}, want: "500"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.to.GetCode()+" "+test.to.GetValue(), func(t *testing.T) {
+			response, err := server.ReverseConvert(context.Background(), &pb.ReverseConversionRequest{From: "USD", To: test.to})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := response.GetConverted().GetValue(); got != test.want {
+				t.Errorf("got [%s], want [%s]", got, test.want)
+			}
+		})
+	}
+}
+
+func TestConvertBasketIsNotPriced(t *testing.T) {
+	server := newPricedTestServer(t, map[string]float32{"EUR": 0.8}, []pricing.Rule{{
+		SpreadPercent: converter.NewDecimal(1, 0),
+		FixedFee:      converter.NewDecimal(1, 0),
+		MinimumFee:    converter.NewDecimal(3, 0),
+	}})
+
+	response, err := server.ConvertBasket(context.Background(), &pb.ConvertBasketRequest{
+		Amounts: []*pb.Currency{{Code: "USD", Value: "100"}, {Code: "EUR", Value: "80"}},
+		To:      "EUR",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, leg := range response.GetLegs() {
+		if leg.GetFees() != nil {
+			t.Errorf("got the fees [%v] for the leg %d, want none", leg.GetFees(), i)
+		}
+
+		if got := leg.GetConverted().GetValue(); got != "80.00" {
+			t.Errorf("got [%s] for the leg %d, want [80.00]", got, i)
+		}
+	}
+
+	if got := response.GetTotal().GetValue(); got != "160.00" {
+		t.Errorf("got the total [%s], want [160.00]", got)
+	}
+}
+
+func TestConvertRejectsAnUnknownRoundingMode(t *testing.T) {
+	server := newRatedTestServer(t, map[string]float32{"EUR": 0.8})
+
+	_, err := server.Convert(context.Background(), &pb.ConversionRequest{
+		From:         &pb.Currency{Code: "USD", Value: "1"},
+		To:           "EUR",
+		RoundingMode: pb.RoundingMode(99),
+	})
+
+	for _, detail := range status.Convert(err).Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			if field := badRequest.GetFieldViolations()[0].GetField(); field != "rounding_mode" {
+				t.Errorf("got the field violation [%s], want [rounding_mode]", field)
+			}
+
+			return
+		}
+	}
+
+	t.Fatalf("got the error [%v], want a field violation", err)
+}
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "currency-converter/api/pb/v1alpha1/currencyconverter"
+	"currency-converter/internal/cache"
+	"currency-converter/internal/errors"
+	"currency-converter/pkg/converter"
+	"currency-converter/pkg/currency"
+)
+
+// exchangeRate is the rate converting a currency into another, with the path it was derived from.
+type exchangeRate struct {
+	from, to currency.Currency
+	rate     converter.Decimal
+	path     path
+}
+
+// exchangeRate derives the rate converting from into to from the rates tables, through the pivot currencies if needed.
+func (server *converterServer) exchangeRate(tables []*cache.Rates, from, to currency.Currency) (*exchangeRate, error) {
+	return server.exchangeRateThrough(legs(tables), from, to)
+}
+
+// exchangeRateThrough derives the rate converting from into to from the legs of the rates tables, by source currency.
+func (server *converterServer) exchangeRateThrough(
+	edges map[string][]leg,
+	from, to currency.Currency) (*exchangeRate, error) {
+	conversionPath, found := findPath(edges, from.Code, to.Code, server.pivots())
+	if !found {
+		return nil, errors.NoConversionPathError(from.Code, to.Code)
+	}
+
+	rate, err := conversionPath.rate()
+	if err != nil {
+		return nil, errors.InvalidExchangeRateError(from.Code)
+	}
+
+	return &exchangeRate{from: from, to: to, rate: rate, path: conversionPath}, nil
+}
+
+// describe sets the exchange rate of the response, with the rates and the path it was derived from.
+func (rate *exchangeRate) describe(response *pb.ConversionResponse) {
+	response.ExchangeRate = rate.rate.Float32()
+	response.ExchangeRateValue = rate.rate.String()
+	response.ConversionPath = rate.path.currencies(rate.from.Code)
+	response.ExchangeRateDatetime = timestamppb.Now()
+
+	if fetchedAt := rate.path.fetchedAt(); !fetchedAt.IsZero() {
+		response.ExchangeRateDatetime = timestamppb.New(fetchedAt)
+	}
+
+	if table, single := rate.path.singleTable(); single {
+		response.BaseCurrency = table.Base
+		response.FromRate = tableRate(table, rate.from.Code).String()
+		response.ToRate = tableRate(table, rate.to.Code).String()
+	}
+}
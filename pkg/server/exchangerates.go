@@ -0,0 +1,223 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "currency-converter/api/pb/v1alpha1/currencyconverter"
+	"currency-converter/internal/cache"
+	"currency-converter/internal/errors"
+	"currency-converter/internal/exchange"
+	"currency-converter/pkg/currency"
+	"currency-converter/pkg/locale"
+)
+
+const (
+	// defaultPageSize is the number of rates of a page when the request does not specify it.
+	defaultPageSize = 100
+
+	// maxPageSize is the largest number of rates of a page, larger sizes are lowered to it.
+	maxPageSize = 1000
+)
+
+func (server *converterServer) ListExchangeRates(
+	ctx context.Context,
+	request *pb.ListExchangeRatesRequest) (*pb.ListExchangeRatesResponse, error) {
+	// TODO: User authentication using ctx
+
+	base, codes, err := lookupQuoted(request.GetBase(), request.GetCodes())
+	if err != nil {
+		return nil, err
+	}
+
+	listLocale, present := locale.Lookup(request.GetLocale())
+	if !present {
+		return nil, errors.FieldViolationError("locale", fmt.Sprintf("unsupported locale [%s]", request.GetLocale()))
+	}
+
+	exProvider, err := server.provider(request.GetExchangeProvider())
+	if err != nil {
+		return nil, err
+	}
+
+	listing := pageToken{Provider: exProvider, Base: base.Code}
+	for _, c := range codes {
+		listing.Codes = append(listing.Codes, c.Code)
+	}
+
+	var tables []*cache.Rates
+
+	var start int
+	if request.GetPageToken() != "" {
+		// the page token pins the rates tables and the position, the offset is ignored.
+		if listing, err = decodePageToken(request.GetPageToken(), listing); err != nil {
+			return nil, err
+		}
+
+		if tables, err = server.pinnedTables(listing); err != nil {
+			return nil, err
+		}
+	} else if tables, err = server.rateTables(listing.Provider); err != nil {
+		return nil, err
+	}
+
+	rates := server.ratesAgainst(tables, base, codes)
+
+	if request.GetPageToken() != "" {
+		start = sort.Search(len(rates), func(i int) bool {
+			return rates[i].to.Code > listing.LastCode
+		})
+	} else {
+		start = minIndex(request.GetPagination().GetOffset(), len(rates))
+	}
+
+	size := request.GetPagination().GetSize()
+	switch {
+	case size == 0:
+		size = defaultPageSize
+	case size > maxPageSize:
+		size = maxPageSize
+	}
+
+	page := rates[start:minIndex(uint64(start)+size, len(rates))]
+
+	response := &pb.ListExchangeRatesResponse{
+		Currencies:           listed(page, request.GetLocale(), listLocale),
+		Base:                 base.Code,
+		ExchangeRateDatetime: timestamppb.New(oldestFetch(page)),
+	}
+
+	if request.GetIncludeTotalCount() {
+		response.TotalCount = float64(len(rates))
+	}
+
+	if len(page) > 0 && start+len(page) < len(rates) {
+		listing.Versions = versionsOf(tables)
+		listing.LastCode = page[len(page)-1].to.Code
+		listing.ExpiresAt = time.Now().Add(server.settings.Get().Cache.RatesTTL).Unix()
+		response.NextPageToken = listing.encode()
+	}
+
+	return response, nil
+}
+
+// lookupQuoted returns the base currency, USD when empty, and the currencies of the codes to list the rates of.
+func lookupQuoted(baseCode string, codes []string) (currency.Currency, []currency.Currency, error) {
+	if baseCode == "" {
+		baseCode = exchange.BaseCurrency
+	}
+
+	base, present := currency.Lookup(baseCode)
+	if !present {
+		return currency.Currency{}, nil, errors.UnknownCurrencyError(baseCode)
+	}
+
+	currencies := make([]currency.Currency, 0, len(codes))
+	for _, code := range codes {
+		c, present := currency.Lookup(code)
+		if !present {
+			return currency.Currency{}, nil, errors.UnknownCurrencyError(code)
+		}
+
+		currencies = append(currencies, c)
+	}
+
+	return base, currencies, nil
+}
+
+// listed returns the rates as currencies, also formatted in the locale when the request has one.
+func listed(rates []*exchangeRate, localeTag string, l locale.Locale) []*pb.Currency {
+	currencies := make([]*pb.Currency, 0, len(rates))
+	for _, rate := range rates {
+		c := &pb.Currency{Code: rate.to.Code, Value: rate.rate.String()}
+		if localeTag != "" {
+			c.FormattedValue = locale.FormatNumber(rate.rate, l)
+		}
+
+		currencies = append(currencies, c)
+	}
+
+	return currencies
+}
+
+// ratesAgainst returns the rates of the currencies against the base, ordered by code.
+// Without currencies, all the currencies of the rates tables are listed.
+// The currencies which cannot be derived from the rates tables are left out.
+func (server *converterServer) ratesAgainst(
+	tables []*cache.Rates,
+	base currency.Currency,
+	currencies []currency.Currency) []*exchangeRate {
+	if len(currencies) == 0 {
+		currencies = currenciesOf(tables)
+	}
+
+	rates := make([]*exchangeRate, 0, len(currencies))
+	for _, c := range currencies {
+		if c.Code == base.Code {
+			continue
+		}
+
+		rate, err := server.exchangeRate(tables, base, c)
+		if err != nil {
+			continue
+		}
+
+		rates = append(rates, rate)
+	}
+
+	sort.Slice(rates, func(i, j int) bool {
+		return rates[i].to.Code < rates[j].to.Code
+	})
+
+	return rates
+}
+
+// currenciesOf returns the currencies of the registry quoted in the rates tables.
+func currenciesOf(tables []*cache.Rates) []currency.Currency {
+	seen := map[string]bool{}
+
+	var currencies []currency.Currency
+	add := func(code string) {
+		if c, present := currency.Lookup(code); present && !seen[c.Code] {
+			seen[c.Code] = true
+			currencies = append(currencies, c)
+		}
+	}
+
+	for _, table := range tables {
+		add(table.Base)
+		for code := range table.Values {
+			add(code)
+		}
+	}
+
+	return currencies
+}
+
+// oldestFetch returns the time at which the oldest rates table of the rates was fetched, now if there is none.
+func oldestFetch(rates []*exchangeRate) time.Time {
+	var oldest time.Time
+	for _, rate := range rates {
+		if fetchedAt := rate.path.fetchedAt(); !fetchedAt.IsZero() && (oldest.IsZero() || fetchedAt.Before(oldest)) {
+			oldest = fetchedAt
+		}
+	}
+
+	if oldest.IsZero() {
+		return time.Now()
+	}
+
+	return oldest
+}
+
+func minIndex(i uint64, length int) int {
+	if i > uint64(length) {
+		return length
+	}
+
+	return int(i)
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "currency-converter/api/pb/v1alpha1/currencyconverter"
+	"currency-converter/internal/cache"
+	"currency-converter/internal/errors"
+	"currency-converter/internal/exchange"
+	"currency-converter/internal/factory"
+	"currency-converter/internal/history"
+	"currency-converter/pkg/locale"
+)
+
+func (server *converterServer) GetHistoricalRates(
+	ctx context.Context,
+	request *pb.HistoricalRatesRequest) (*pb.HistoricalRatesResponse, error) {
+	// TODO: User authentication using ctx
+
+	date, err := time.Parse(history.DateLayout, request.GetDate())
+	if err != nil {
+		return nil, errors.FieldViolationError("date", fmt.Sprintf("[%s] is not a date as YYYY-MM-DD", request.GetDate()))
+	}
+
+	if date.After(time.Now()) {
+		return nil, errors.FieldViolationError("date", fmt.Sprintf("[%s] is in the future", request.GetDate()))
+	}
+
+	base, codes, err := lookupQuoted(request.GetBase(), request.GetCodes())
+	if err != nil {
+		return nil, err
+	}
+
+	listLocale, present := locale.Lookup(request.GetLocale())
+	if !present {
+		return nil, errors.FieldViolationError("locale", fmt.Sprintf("unsupported locale [%s]", request.GetLocale()))
+	}
+
+	exProvider, err := server.provider(request.GetExchangeProvider())
+	if err != nil {
+		return nil, err
+	}
+
+	source := pb.HistoricalRatesSource_HISTORICAL_RATES_SOURCE_HISTORY
+
+	tables, err := server.history.Day(exProvider, date)
+	if errors.IsNotFound(err) {
+		// the date predates the history, or the service was down all day.
+		source = pb.HistoricalRatesSource_HISTORICAL_RATES_SOURCE_PROVIDER
+		tables, err = server.providerHistoricalRates(exProvider, date)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	rates := server.ratesAgainst(tables, base, codes)
+
+	return &pb.HistoricalRatesResponse{
+		Date:                 date.Format(history.DateLayout),
+		Base:                 base.Code,
+		Currencies:           listed(rates, request.GetLocale(), listLocale),
+		ExchangeRateDatetime: timestamppb.New(oldestFetch(rates)),
+		Source:               source,
+	}, nil
+}
+
+// providerHistoricalRates fetches the rates of the date from the historical endpoint of the exchange provider.
+// The rates of a past day are recorded in the history, to be read from it next time.
+func (server *converterServer) providerHistoricalRates(
+	exProvider exchange.ProviderType,
+	date time.Time) ([]*cache.Rates, error) {
+	provider := factory.NewExchangeRatesProviderFactory().BuildExchangeRatesProvider(exProvider)
+
+	quoter, supported := provider.(exchange.HistoricalQuoter)
+	if !supported {
+		return nil, status.Errorf(codes.NotFound, "no rates of [%s] for the provider [%s]",
+			date.Format(history.DateLayout), exProvider)
+	}
+
+	values, err := quoter.HistoricalRates(date)
+	if err != nil {
+		logrus.WithError(err).Errorf("failed to fetch the rates of [%s] from the provider [%s]",
+			date.Format(history.DateLayout), exProvider)
+		return nil, errors.UpstreamExchangeRateServerError
+	}
+
+	// the provider quotes the closing rates of the day.
+	table := &cache.Rates{
+		Base:      exchange.BaseCurrency,
+		Values:    values,
+		FetchedAt: history.StartOfDay(date).Add(24*time.Hour - time.Second),
+	}
+
+	if table.FetchedAt.Before(time.Now()) {
+		if err = server.history.Record(exProvider, table); err != nil {
+			logrus.WithError(err).Warnf("failed to record the rates of [%s] of the provider [%s]",
+				date.Format(history.DateLayout), exProvider)
+		}
+	}
+
+	return []*cache.Rates{table}, nil
+}
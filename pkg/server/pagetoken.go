@@ -0,0 +1,106 @@
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"time"
+
+	"currency-converter/internal/cache"
+	"currency-converter/internal/errors"
+	"currency-converter/internal/exchange"
+)
+
+// pageToken is the position of a listing of exchange rates, pinned to the rates tables of its first page,
+// so that the following pages are listed from the same rates even if the cache is refreshed meanwhile.
+type pageToken struct {
+	Provider exchange.ProviderType `json:"p"`
+	Base     string                `json:"b"`
+	Codes    []string              `json:"c,omitempty"`
+
+	// Versions holds the version of every rates table of the listing, by base currency.
+	Versions map[string]uint64 `json:"v"`
+
+	// LastCode is the code of the last currency listed, the next page starts after it.
+	LastCode string `json:"l"`
+
+	// ExpiresAt is the unix time after which the token is rejected.
+	ExpiresAt int64 `json:"e"`
+}
+
+var (
+	invalidPageTokenError = errors.FieldViolationError("page_token", "page token is invalid for this request")
+	expiredPageTokenError = errors.FieldViolationError("page_token",
+		"page token expired, the exchange rates it was listing were evicted: list again from the first page")
+)
+
+// encode returns the opaque form of the token.
+func (token pageToken) encode() string {
+	content, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(content)
+}
+
+// decodePageToken returns the token from its opaque form, as long as it was issued for the same listing.
+func decodePageToken(encoded string, listing pageToken) (pageToken, error) {
+	content, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return pageToken{}, invalidPageTokenError
+	}
+
+	var token pageToken
+	if err = json.Unmarshal(content, &token); err != nil || len(token.Versions) == 0 {
+		return pageToken{}, invalidPageTokenError
+	}
+
+	if token.Provider != listing.Provider || token.Base != listing.Base || !reflect.DeepEqual(token.Codes, listing.Codes) {
+		return pageToken{}, invalidPageTokenError
+	}
+
+	if time.Now().Unix() > token.ExpiresAt {
+		return pageToken{}, expiredPageTokenError
+	}
+
+	return token, nil
+}
+
+// pinnedTables returns the rates tables the token is pinned to.
+// Returns an error once one of them is evicted from the cache.
+func (server *converterServer) pinnedTables(token pageToken) ([]*cache.Rates, error) {
+	pivots := server.pivots()
+
+	bases := make([]string, 0, len(token.Versions))
+	for base := range token.Versions {
+		bases = append(bases, base)
+	}
+
+	sort.Slice(bases, func(i, j int) bool {
+		return pivotRank(pivots, bases[i], bases[j])
+	})
+
+	tables := make([]*cache.Rates, 0, len(bases))
+	for _, base := range bases {
+		rates, err := server.store.GetRatesVersion(token.Provider, base, token.Versions[base])
+		if errors.IsNotFound(err) {
+			return nil, expiredPageTokenError
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		tables = append(tables, rates)
+	}
+
+	return tables, nil
+}
+
+// versionsOf returns the version of every rates table, by base currency.
+func versionsOf(tables []*cache.Rates) map[string]uint64 {
+	versions := make(map[string]uint64, len(tables))
+	for _, table := range tables {
+		versions[table.Base] = table.Version
+	}
+
+	return versions
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"context"
+	stderrors "errors"
+
+	"google.golang.org/grpc/metadata"
+
+	pb "currency-converter/api/pb/v1alpha1/currencyconverter"
+	"currency-converter/internal/errors"
+	"currency-converter/internal/exchange"
+	"currency-converter/pkg/currency"
+	"currency-converter/pkg/pricing"
+)
+
+// ClientIDHeader is the metadata identifying the client of a request, the quotes are bound to it.
+// The gateway forwards it from the X-Client-Id header. It is not authenticated, it must not grant anything.
+const ClientIDHeader = "x-client-id"
+
+// clientID returns the client of the request, empty when not identified.
+func clientID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if values := md.Get(ClientIDHeader); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// pricingRule returns the pricing rule of the conversion, if any applies.
+func (server *converterServer) pricingRule(
+	exProvider exchange.ProviderType,
+	from, to currency.Currency) (pricing.Rule, bool) {
+	return pricing.Select(server.settings.Get().Pricing.Rules, exProvider, from.Code, to.Code)
+}
+
+// fees returns the breakdown of the price of a conversion from the source currency.
+func fees(price pricing.Price, from currency.Currency) *pb.Fees {
+	amount := func(value string) *pb.Currency {
+		return &pb.Currency{Code: from.Code, Value: value}
+	}
+
+	return &pb.Fees{
+		MidRate:       price.MidRate.String(),
+		AppliedRate:   price.AppliedRate.String(),
+		SpreadPercent: price.SpreadPercent.String(),
+		SpreadAmount:  amount(price.SpreadAmount.StringFixed(from.MinorUnits)),
+		FeeAmount:     amount(price.Fee.StringFixed(from.MinorUnits)),
+		NetAmount:     amount(price.Net.StringFixed(from.MinorUnits)),
+	}
+}
+
+// describePrice sets the fees of the response, whose exchange rate becomes the applied rate.
+func describePrice(response *pb.ConversionResponse, price pricing.Price, from currency.Currency) {
+	response.ExchangeRate = price.AppliedRate.Float32()
+	response.ExchangeRateValue = price.AppliedRate.String()
+	response.Fees = fees(price, from)
+}
+
+// pricingError returns the error of the API for an error pricing the amount of the field.
+func pricingError(field string, err error) error {
+	if stderrors.Is(err, pricing.ErrAmountBelowFees) {
+		return errors.FieldViolationError(field, err.Error())
+	}
+
+	return errors.AmountOverflowError
+}
@@ -0,0 +1,274 @@
+package server
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "currency-converter/api/pb/v1alpha1/currencyconverter"
+	"currency-converter/internal/cache/inmemory"
+	"currency-converter/internal/config"
+	"currency-converter/internal/health"
+	"currency-converter/internal/history/file"
+	"currency-converter/internal/watch"
+)
+
+const unknownProvider = "bogus"
+
+func newTestServer(t *testing.T) pb.CurrencyConverterServiceServer {
+	t.Helper()
+
+	return newShutdownTestServer(t, make(chan struct{}))
+}
+
+// newShutdownTestServer returns a server which shuts down once shutdown is closed.
+func newShutdownTestServer(t *testing.T, shutdown <-chan struct{}) pb.CurrencyConverterServiceServer {
+	t.Helper()
+
+	cfg := config.Default()
+	cfg.History.Dir = t.TempDir()
+
+	settings := config.NewHolder(cfg)
+	monitor := health.NewMonitor(settings)
+
+	return NewServer(
+		inmemory.NewStore(settings, monitor),
+		inmemory.NewQuoteStore(),
+		file.NewStore(settings),
+		watch.NewHub(),
+		monitor,
+		settings,
+		shutdown)
+}
+
+// convertStream is a ConvertStream of the requests, collecting the responses.
+// Once the requests are all received, the stream is closed by the client, or stays open while idle is set.
+type convertStream struct {
+	grpc.ServerStream
+
+	requests  []*pb.ConvertStreamRequest
+	responses []*pb.ConvertStreamResponse
+
+	idle <-chan struct{}
+}
+
+func (stream *convertStream) Context() context.Context {
+	return context.Background()
+}
+
+func (stream *convertStream) Recv() (*pb.ConvertStreamRequest, error) {
+	if len(stream.requests) == 0 {
+		if stream.idle != nil {
+			<-stream.idle
+		}
+
+		return nil, io.EOF
+	}
+
+	request := stream.requests[0]
+	stream.requests = stream.requests[1:]
+
+	return request, nil
+}
+
+func (stream *convertStream) Send(response *pb.ConvertStreamResponse) error {
+	stream.responses = append(stream.responses, response)
+	return nil
+}
+
+func TestUnknownProviderIsRejected(t *testing.T) {
+	conversion := &pb.ConversionRequest{
+		From:             &pb.Currency{Code: "USD", Value: "1"},
+		To:               "EUR",
+		ExchangeProvider: unknownProvider,
+	}
+
+	tests := []struct {
+		name string
+		call func(server pb.CurrencyConverterServiceServer) error
+	}{
+		{
+			name: "Convert",
+			call: func(server pb.CurrencyConverterServiceServer) error {
+				_, err := server.Convert(context.Background(), conversion)
+				return err
+			},
+		},
+		{
+			name: "ReverseConvert",
+			call: func(server pb.CurrencyConverterServiceServer) error {
+				_, err := server.ReverseConvert(context.Background(), &pb.ReverseConversionRequest{
+					From:             "USD",
+					To:               &pb.Currency{Code: "EUR", Value: "1"},
+					ExchangeProvider: unknownProvider,
+				})
+				return err
+			},
+		},
+		{
+			name: "BatchConvert",
+			call: func(server pb.CurrencyConverterServiceServer) error {
+				response, err := server.BatchConvert(context.Background(), &pb.BatchConversionRequest{
+					Currencies: []*pb.ConversionRequest{conversion},
+				})
+				if err != nil {
+					return err
+				}
+
+				return status.ErrorProto(response.GetResults()[0].GetStatus())
+			},
+		},
+		{
+			name: "ConvertStream",
+			call: func(server pb.CurrencyConverterServiceServer) error {
+				stream := &convertStream{requests: []*pb.ConvertStreamRequest{{Id: "1", Conversion: conversion}}}
+				if err := server.ConvertStream(stream); err != nil {
+					return err
+				}
+
+				return status.ErrorProto(stream.responses[0].GetStatus())
+			},
+		},
+		{
+			name: "ListExchangeRates",
+			call: func(server pb.CurrencyConverterServiceServer) error {
+				_, err := server.ListExchangeRates(context.Background(), &pb.ListExchangeRatesRequest{
+					ExchangeProvider: unknownProvider,
+				})
+				return err
+			},
+		},
+		{
+			name: "GetHistoricalRates",
+			call: func(server pb.CurrencyConverterServiceServer) error {
+				_, err := server.GetHistoricalRates(context.Background(), &pb.HistoricalRatesRequest{
+					Date:             "2024-01-01",
+					ExchangeProvider: "../evil",
+				})
+				return err
+			},
+		},
+		{
+			name: "GetRateTimeSeries",
+			call: func(server pb.CurrencyConverterServiceServer) error {
+				_, err := server.GetRateTimeSeries(context.Background(), &pb.RateTimeSeriesRequest{
+					From:             "USD",
+					To:               "EUR",
+					StartTime:        timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+					EndTime:          timestamppb.New(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)),
+					ExchangeProvider: "../evil",
+				})
+				return err
+			},
+		},
+		{
+			name: "CreateQuote",
+			call: func(server pb.CurrencyConverterServiceServer) error {
+				_, err := server.CreateQuote(context.Background(), &pb.CreateQuoteRequest{
+					From:             "USD",
+					To:               "EUR",
+					ExchangeProvider: unknownProvider,
+				})
+				return err
+			},
+		},
+		{
+			name: "ConvertBasket",
+			call: func(server pb.CurrencyConverterServiceServer) error {
+				_, err := server.ConvertBasket(context.Background(), &pb.ConvertBasketRequest{
+					Amounts:          []*pb.Currency{{Code: "USD", Value: "1"}},
+					To:               "EUR",
+					ExchangeProvider: unknownProvider,
+				})
+				return err
+			},
+		},
+		{
+			name: "ConvertToMany",
+			call: func(server pb.CurrencyConverterServiceServer) error {
+				_, err := server.ConvertToMany(context.Background(), &pb.ConvertToManyRequest{
+					From:             &pb.Currency{Code: "USD", Value: "1"},
+					To:               []string{"EUR"},
+					ExchangeProvider: unknownProvider,
+				})
+				return err
+			},
+		},
+		{
+			name: "ListCurrencies",
+			call: func(server pb.CurrencyConverterServiceServer) error {
+				_, err := server.ListCurrencies(context.Background(), &pb.ListCurrenciesRequest{
+					ExchangeProvider: unknownProvider,
+				})
+				return err
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.call(newTestServer(t))
+			if status.Code(err) != codes.InvalidArgument {
+				t.Fatalf("got the error [%v], want InvalidArgument", err)
+			}
+		})
+	}
+}
+
+func TestListCurrenciesDefaultsToTheDefaultProvider(t *testing.T) {
+	response, err := newRatedTestServer(t, map[string]float32{"USD": 1, "EUR": 0.9}).
+		ListCurrencies(context.Background(), &pb.ListCurrenciesRequest{})
+	if err != nil {
+		t.Fatalf("got the error [%v], want the currencies of the default provider", err)
+	}
+
+	if provider := response.GetExchangeProvider(); provider != string(config.Default().Exchange.DefaultProvider) {
+		t.Errorf("got the provider [%s], want the default provider", provider)
+	}
+
+	rated := map[string]bool{}
+	for _, c := range response.GetCurrencies() {
+		rated[c.GetCode()] = c.GetRateCached()
+	}
+
+	if !rated["EUR"] {
+		t.Errorf("got the currencies %v, want EUR with a cached rate", response.GetCurrencies())
+	}
+}
+
+func TestBatchConvertRejectsUnknownProviderPerItem(t *testing.T) {
+	response, err := newTestServer(t).BatchConvert(context.Background(), &pb.BatchConversionRequest{
+		Currencies: []*pb.ConversionRequest{
+			{From: &pb.Currency{Code: "USD", Value: "1"}, To: "EUR", ExchangeProvider: unknownProvider},
+			{From: &pb.Currency{Code: "ABC", Value: "1"}, To: "EUR"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("got the error [%v], want the statuses of the items", err)
+	}
+
+	if code := codes.Code(response.GetResults()[0].GetStatus().GetCode()); code != codes.InvalidArgument {
+		t.Errorf("got the code [%s] for the unknown provider, want InvalidArgument", code)
+	}
+
+	if code := codes.Code(response.GetResults()[1].GetStatus().GetCode()); code != codes.InvalidArgument {
+		t.Errorf("got the code [%s] for the unknown currency, want InvalidArgument", code)
+	}
+}
+
+func TestTimeSeriesTokenOfAnotherProviderIsRejected(t *testing.T) {
+	// the series of the request has a validated provider, the token must have the same one.
+	series := timeSeriesToken{Provider: "fixer", From: "USD", To: "EUR", Start: 0, End: 7200, Next: 3600}
+	token := series
+	token.Provider = "../evil"
+
+	if _, err := decodeTimeSeriesToken(token.encode(), series); err != invalidPageTokenError {
+		t.Fatalf("got the error [%v], want the invalid page token error", err)
+	}
+}
@@ -0,0 +1,140 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "currency-converter/api/pb/v1alpha1/currencyconverter"
+	"currency-converter/internal/exchange"
+	"currency-converter/internal/factory"
+	"currency-converter/pkg/currency"
+)
+
+func (server *converterServer) ListProviders(
+	ctx context.Context,
+	request *pb.ListProvidersRequest) (*pb.ListProvidersResponse, error) {
+	// TODO: User authentication using ctx
+
+	cfg := server.settings.Get()
+
+	refreshed := map[exchange.ProviderType]bool{}
+	for _, exProvider := range cfg.Exchange.Providers {
+		refreshed[exProvider] = true
+	}
+
+	response := &pb.ListProvidersResponse{}
+	for _, exProvider := range exchange.GetSupportedProviders() {
+		described := &pb.Provider{
+			Name:         string(exProvider),
+			Default:      exProvider == cfg.Exchange.DefaultProvider,
+			Capabilities: capabilities(exProvider),
+			Refreshed:    refreshed[exProvider],
+			Health:       pb.ProviderHealth_PROVIDER_HEALTH_HEALTHY,
+		}
+
+		if since, failing := server.monitor.FailingSince(exProvider); failing {
+			described.FailingSince = timestamppb.New(since)
+			described.Health = pb.ProviderHealth_PROVIDER_HEALTH_DEGRADED
+
+			if time.Since(since) >= cfg.Health.ProvidersFailureWindow {
+				described.Health = pb.ProviderHealth_PROVIDER_HEALTH_UNAVAILABLE
+			}
+		}
+
+		response.Providers = append(response.Providers, described)
+	}
+
+	sort.Slice(response.Providers, func(i, j int) bool {
+		return response.Providers[i].Name < response.Providers[j].Name
+	})
+
+	return response, nil
+}
+
+// capabilities returns what the exchange provider can be used for, from the interfaces it implements.
+func capabilities(exProvider exchange.ProviderType) []pb.ProviderCapability {
+	provider := factory.NewExchangeRatesProviderFactory().BuildExchangeRatesProvider(exProvider)
+
+	capabilities := []pb.ProviderCapability{pb.ProviderCapability_PROVIDER_CAPABILITY_LIVE_RATES}
+
+	if _, ok := provider.(exchange.BaseQuoter); ok {
+		capabilities = append(capabilities, pb.ProviderCapability_PROVIDER_CAPABILITY_PIVOT_RATES)
+	}
+
+	if _, ok := provider.(exchange.HistoricalQuoter); ok {
+		capabilities = append(capabilities, pb.ProviderCapability_PROVIDER_CAPABILITY_HISTORICAL_RATES)
+	}
+
+	return capabilities
+}
+
+func (server *converterServer) ListCurrencies(
+	ctx context.Context,
+	request *pb.ListCurrenciesRequest) (*pb.ListCurrenciesResponse, error) {
+	// TODO: User authentication using ctx
+
+	exProvider, err := server.provider(request.GetExchangeProvider())
+	if err != nil {
+		return nil, err
+	}
+
+	tables, err := server.cachedRateTables(exProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	// rated holds the currencies with a cached live rate.
+	rated := map[string]bool{}
+	for _, c := range currenciesOf(tables) {
+		rated[c.Code] = true
+	}
+
+	var supported []currency.Currency
+
+	codes, err := server.store.AvailableCurrencies(exProvider)
+	switch {
+	case err == nil:
+		for _, code := range codes {
+			if c, present := currency.Lookup(code); present {
+				supported = append(supported, c)
+			}
+		}
+	case len(rated) > 0:
+		// the provider does not list its currencies, the ones it has rates of are supported.
+		logrus.WithError(err).Warnf("failed to list the currencies of the provider [%s], listing its rates", exProvider)
+		supported = currenciesOf(tables)
+	default:
+		return nil, err
+	}
+
+	sort.Slice(supported, func(i, j int) bool {
+		return supported[i].Code < supported[j].Code
+	})
+
+	response := &pb.ListCurrenciesResponse{
+		ExchangeProvider: string(exProvider),
+		Currencies:       make([]*pb.CurrencyDescription, 0, len(supported)),
+	}
+
+	for i, c := range supported {
+		if i > 0 && supported[i-1].Code == c.Code {
+			continue
+		}
+
+		response.Currencies = append(response.Currencies, &pb.CurrencyDescription{
+			Code:        c.Code,
+			NumericCode: c.Numeric,
+			Name:        c.Name,
+			MinorUnits:  c.MinorUnits,
+			Symbol:      c.Symbol,
+			Crypto:      c.Crypto,
+			RateCached:  rated[c.Code],
+		})
+	}
+
+	return response, nil
+}
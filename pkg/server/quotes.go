@@ -0,0 +1,175 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "currency-converter/api/pb/v1alpha1/currencyconverter"
+	"currency-converter/internal/cache"
+	"currency-converter/internal/errors"
+	"currency-converter/pkg/currency"
+	"currency-converter/pkg/pricing"
+)
+
+func (server *converterServer) CreateQuote(ctx context.Context, request *pb.CreateQuoteRequest) (*pb.Quote, error) {
+	// TODO: User authentication using ctx
+
+	from, present := currency.Lookup(request.GetFrom())
+	if !present {
+		return nil, errors.UnknownCurrencyError(request.GetFrom())
+	}
+
+	to, present := currency.Lookup(request.GetTo())
+	if !present {
+		return nil, errors.UnknownCurrencyError(request.GetTo())
+	}
+
+	exProvider, err := server.provider(request.GetExchangeProvider())
+	if err != nil {
+		return nil, err
+	}
+
+	tables, err := server.rateTables(exProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	rate, err := server.exchangeRate(tables, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := newQuoteID()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to create the quote id")
+	}
+
+	now := time.Now()
+	quote := &cache.Quote{
+		ID:             id,
+		ClientID:       clientID(ctx),
+		Provider:       exProvider,
+		From:           from.Code,
+		To:             to.Code,
+		MidRate:        rate.rate,
+		Path:           rate.path.currencies(from.Code),
+		RatesFetchedAt: rate.path.fetchedAt(),
+		CreatedAt:      now,
+		ExpiresAt:      now.Add(server.settings.Get().Conversion.QuoteTTL),
+	}
+
+	if quote.RatesFetchedAt.IsZero() {
+		quote.RatesFetchedAt = now
+	}
+
+	appliedRate := rate.rate
+	if rule, priced := server.pricingRule(exProvider, from, to); priced {
+		quote.Rule = &rule
+
+		if appliedRate, err = rule.AppliedRate(rate.rate); err != nil {
+			return nil, errors.AmountOverflowError
+		}
+	}
+
+	if err = server.quotes.SetQuote(quote); err != nil {
+		return nil, errors.InternalCacheError
+	}
+
+	return &pb.Quote{
+		Id:                   quote.ID,
+		From:                 quote.From,
+		To:                   quote.To,
+		ExchangeProvider:     string(quote.Provider),
+		ExchangeRate:         appliedRate.String(),
+		MidRate:              quote.MidRate.String(),
+		ConversionPath:       quote.Path,
+		ExchangeRateDatetime: timestamppb.New(quote.RatesFetchedAt),
+		CreateTime:           timestamppb.New(quote.CreatedAt),
+		ExpireTime:           timestamppb.New(quote.ExpiresAt),
+	}, nil
+}
+
+func (server *converterServer) ConvertWithQuote(
+	ctx context.Context,
+	request *pb.ConvertWithQuoteRequest) (*pb.ConversionResponse, error) {
+	// TODO: User authentication using ctx
+
+	quote, err := server.quotes.GetQuote(request.GetQuoteId())
+	if err != nil {
+		return nil, err
+	}
+
+	if quote.ClientID != clientID(ctx) {
+		// the quotes of the other clients are not disclosed.
+		return nil, errors.QuoteNotFoundError(request.GetQuoteId())
+	}
+
+	if quote.IsExpired(time.Now()) {
+		return nil, errors.QuoteExpiredError(quote.ID)
+	}
+
+	// the quote converts from its currency, the request may omit it. The codes are case-insensitive.
+	if code := request.GetFrom().GetCode(); code != "" {
+		if from, _ := currency.Lookup(code); from.Code != quote.From {
+			return nil, errors.FieldViolationError("from.code", fmt.Sprintf("the quote converts from [%s]", quote.From))
+		}
+	}
+
+	c, err := server.parseConversion(&pb.ConversionRequest{
+		From:         &pb.Currency{Code: quote.From, Value: request.GetFrom().GetValue()},
+		To:           quote.To,
+		RoundingMode: request.GetRoundingMode(),
+		Locale:       request.GetLocale(),
+	}, pb.RoundingMode_ROUNDING_MODE_UNSPECIFIED)
+	if err != nil {
+		return nil, err
+	}
+
+	var rule pricing.Rule
+	if quote.Rule != nil {
+		rule = *quote.Rule
+	}
+
+	response, price, err := c.at(quote.MidRate, rule, quote.Rule != nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// the quote is used once the conversion succeeded, by only one of the concurrent conversions.
+	if err = server.quotes.UseQuote(quote.ID, time.Now()); err != nil {
+		return nil, err
+	}
+
+	describeQuote(response, quote)
+
+	if price != nil {
+		describePrice(response, *price, c.from)
+	}
+
+	return response, nil
+}
+
+// describeQuote sets the exchange rate locked by the quote in the response.
+func describeQuote(response *pb.ConversionResponse, quote *cache.Quote) {
+	response.ExchangeRate = quote.MidRate.Float32()
+	response.ExchangeRateValue = quote.MidRate.String()
+	response.ConversionPath = quote.Path
+	response.ExchangeRateDatetime = timestamppb.New(quote.RatesFetchedAt)
+}
+
+// newQuoteID returns a random quote id.
+func newQuoteID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(id), nil
+}
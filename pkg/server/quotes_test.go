@@ -0,0 +1,48 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "currency-converter/api/pb/v1alpha1/currencyconverter"
+)
+
+func TestConvertWithQuoteComparesTheCodesCaseInsensitively(t *testing.T) {
+	server := newRatedTestServer(t, map[string]float32{"EUR": 0.8})
+
+	tests := []struct {
+		code string
+		want codes.Code
+	}{
+		{code: "", want: codes.OK},
+		{code: "USD", want: codes.OK},
+		{code: "usd", want: codes.OK},
+		{code: "eur", want: codes.InvalidArgument},
+		{code: "ABC", want: codes.InvalidArgument},
+	}
+
+	for _, test := range tests {
+		t.Run(test.code, func(t *testing.T) {
+			// a quote is used once.
+			quote, err := server.CreateQuote(context.Background(), &pb.CreateQuoteRequest{From: "USD", To: "EUR"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			response, err := server.ConvertWithQuote(context.Background(), &pb.ConvertWithQuoteRequest{
+				QuoteId: quote.GetId(),
+				From:    &pb.Currency{Code: test.code, Value: "100"},
+			})
+			if status.Code(err) != test.want {
+				t.Fatalf("got the error [%v], want %s", err, test.want)
+			}
+
+			if test.want == codes.OK && response.GetConverted().GetValue() != "80.00" {
+				t.Errorf("got [%s], want [80.00]", response.GetConverted().GetValue())
+			}
+		})
+	}
+}
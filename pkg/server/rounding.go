@@ -0,0 +1,42 @@
+package server
+
+import (
+	"fmt"
+
+	pb "currency-converter/api/pb/v1alpha1/currencyconverter"
+	"currency-converter/internal/errors"
+	"currency-converter/pkg/converter"
+)
+
+// roundingModes maps the rounding modes of the API to the ones of the converter.
+var roundingModes = map[pb.RoundingMode]converter.RoundingMode{
+	pb.RoundingMode_ROUNDING_MODE_HALF_EVEN: converter.HalfEven,
+	pb.RoundingMode_ROUNDING_MODE_HALF_UP:   converter.HalfUp,
+	pb.RoundingMode_ROUNDING_MODE_FLOOR:     converter.Floor,
+	pb.RoundingMode_ROUNDING_MODE_CEILING:   converter.Ceiling,
+}
+
+// roundingMode returns the rounding mode to apply for the requested one, which falls back to fallback when
+// unspecified, and then to the default rounding mode of the deployment.
+// Returns the mode for the converter and its API counterpart to be recorded in the response.
+func (server *converterServer) roundingMode(requested, fallback pb.RoundingMode) (converter.RoundingMode, pb.RoundingMode, error) {
+	if requested == pb.RoundingMode_ROUNDING_MODE_UNSPECIFIED {
+		requested = fallback
+	}
+
+	if requested == pb.RoundingMode_ROUNDING_MODE_UNSPECIFIED {
+		mode := server.settings.Get().Conversion.DefaultRoundingMode
+		for apiMode, converterMode := range roundingModes {
+			if converterMode == mode {
+				return mode, apiMode, nil
+			}
+		}
+	}
+
+	mode, present := roundingModes[requested]
+	if !present {
+		return 0, 0, errors.FieldViolationError("rounding_mode", fmt.Sprintf("unsupported rounding mode [%s]", requested))
+	}
+
+	return mode, requested, nil
+}
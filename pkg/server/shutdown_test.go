@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "currency-converter/api/pb/v1alpha1/currencyconverter"
+)
+
+// watchStream is a WatchExchangeRates stream, collecting the events sent.
+type watchStream struct {
+	grpc.ServerStream
+
+	events []*pb.WatchExchangeRatesResponse
+}
+
+func (stream *watchStream) Context() context.Context {
+	return context.Background()
+}
+
+func (stream *watchStream) Send(event *pb.WatchExchangeRatesResponse) error {
+	stream.events = append(stream.events, event)
+	return nil
+}
+
+// endsOnShutdown returns the error the stream ended with, once shutdown is closed while it is open.
+func endsOnShutdown(t *testing.T, shutdown chan struct{}, serve func() error) error {
+	t.Helper()
+
+	ended := make(chan error, 1)
+	go func() {
+		ended <- serve()
+	}()
+
+	select {
+	case err := <-ended:
+		t.Fatalf("the stream ended with [%v] before the shutdown", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(shutdown)
+
+	select {
+	case err := <-ended:
+		return err
+	case <-time.After(time.Second):
+		t.Fatal("the stream did not end on shutdown")
+		return nil
+	}
+}
+
+func TestWatchExchangeRatesEndsOnShutdown(t *testing.T) {
+	shutdown := make(chan struct{})
+	server := newShutdownTestServer(t, shutdown)
+
+	err := endsOnShutdown(t, shutdown, func() error {
+		return server.WatchExchangeRates(&pb.WatchExchangeRatesRequest{}, &watchStream{})
+	})
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("got the error [%v], want Unavailable", err)
+	}
+}
+
+func TestConvertStreamEndsOnShutdown(t *testing.T) {
+	shutdown := make(chan struct{})
+	server := newShutdownTestServer(t, shutdown)
+
+	// the client neither sends nor closes the stream.
+	idle := make(chan struct{})
+	defer close(idle)
+
+	stream := &convertStream{
+		requests: []*pb.ConvertStreamRequest{{Id: "1", Conversion: &pb.ConversionRequest{To: "EUR"}}},
+		idle:     idle,
+	}
+
+	err := endsOnShutdown(t, shutdown, func() error {
+		return server.ConvertStream(stream)
+	})
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("got the error [%v], want Unavailable", err)
+	}
+
+	if len(stream.responses) != 1 || stream.responses[0].GetId() != "1" {
+		t.Fatalf("got the responses %v, want the response of the conversion received before the shutdown", stream.responses)
+	}
+}
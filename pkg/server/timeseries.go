@@ -0,0 +1,352 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "currency-converter/api/pb/v1alpha1/currencyconverter"
+	"currency-converter/internal/cache"
+	"currency-converter/internal/errors"
+	"currency-converter/internal/exchange"
+	"currency-converter/pkg/converter"
+	"currency-converter/pkg/currency"
+)
+
+const (
+	// maxDailyPeriods is the largest number of days of a time series by day.
+	maxDailyPeriods = 366
+
+	// maxHourlyPeriods is the largest number of hours of a time series by hour, i.e. 31 days.
+	maxHourlyPeriods = 31 * 24
+
+	// maxPageRecords is the largest number of rates tables read for a page of a time series,
+	// the page ends earlier when its periods have more.
+	maxPageRecords = 10000
+)
+
+func (server *converterServer) GetRateTimeSeries(
+	ctx context.Context,
+	request *pb.RateTimeSeriesRequest) (*pb.RateTimeSeriesResponse, error) {
+	// TODO: User authentication using ctx
+
+	from, present := currency.Lookup(request.GetFrom())
+	if !present {
+		return nil, errors.UnknownCurrencyError(request.GetFrom())
+	}
+
+	to, present := currency.Lookup(request.GetTo())
+	if !present {
+		return nil, errors.UnknownCurrencyError(request.GetTo())
+	}
+
+	granularity := request.GetGranularity()
+	if granularity == pb.TimeSeriesGranularity_TIME_SERIES_GRANULARITY_UNSPECIFIED {
+		granularity = pb.TimeSeriesGranularity_TIME_SERIES_GRANULARITY_DAY
+	}
+
+	period, maxPeriods := 24*time.Hour, maxDailyPeriods
+	switch granularity {
+	case pb.TimeSeriesGranularity_TIME_SERIES_GRANULARITY_DAY:
+	case pb.TimeSeriesGranularity_TIME_SERIES_GRANULARITY_HOUR:
+		period, maxPeriods = time.Hour, maxHourlyPeriods
+	default:
+		return nil, errors.FieldViolationError("granularity", fmt.Sprintf("unsupported granularity [%s]", granularity))
+	}
+
+	if request.GetStartTime() == nil {
+		return nil, errors.FieldViolationError("start_time", "must be set")
+	}
+
+	if request.GetEndTime() == nil {
+		return nil, errors.FieldViolationError("end_time", "must be set")
+	}
+
+	start := request.GetStartTime().AsTime().Truncate(period)
+	end := request.GetEndTime().AsTime()
+
+	if !end.After(start) {
+		return nil, errors.FieldViolationError("end_time", "must be after start_time")
+	}
+
+	if periods := (end.Sub(start) + period - 1) / period; periods > time.Duration(maxPeriods) {
+		return nil, errors.FieldViolationError("end_time",
+			fmt.Sprintf("the range must not have more than %d periods of [%s]", maxPeriods, granularity))
+	}
+
+	exProvider, err := server.provider(request.GetExchangeProvider())
+	if err != nil {
+		return nil, err
+	}
+
+	series := timeSeriesToken{
+		Provider:    exProvider,
+		From:        from.Code,
+		To:          to.Code,
+		Granularity: granularity,
+		Start:       start.Unix(),
+		End:         end.Unix(),
+		Next:        start.Unix(),
+	}
+
+	if request.GetPageToken() != "" {
+		var err error
+		if series, err = decodeTimeSeriesToken(request.GetPageToken(), series); err != nil {
+			return nil, err
+		}
+	}
+
+	size := request.GetPageSize()
+	switch {
+	case size == 0:
+		size = defaultPageSize
+	case size > maxPageSize:
+		size = maxPageSize
+	}
+
+	pageStart := time.Unix(series.Next, 0).UTC()
+	pageEnd := end
+	if pageStart.Add(time.Duration(size) * period).Before(end) {
+		pageEnd = pageStart.Add(time.Duration(size) * period)
+	}
+
+	// the rates of the first periods of the page are derived with the tables recorded before it as well.
+	seed, err := server.history.Before(series.Provider, pageStart)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	var records []*cache.Rates
+	if granularity == pb.TimeSeriesGranularity_TIME_SERIES_GRANULARITY_DAY {
+		records, err = server.closingRecords(series.Provider, pageStart, pageEnd, period)
+	} else {
+		records, err = server.history.Range(series.Provider, pageStart, pageEnd, maxPageRecords+1)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) > maxPageRecords {
+		// the page ends with the last period whose tables are all read.
+		cut := records[maxPageRecords].FetchedAt.UTC().Truncate(period)
+		if !cut.After(pageStart) {
+			return nil, status.Errorf(codes.ResourceExhausted,
+				"the period of [%s] has more than %d rates tables", pageStart.Format(time.RFC3339), maxPageRecords)
+		}
+
+		pageEnd = cut
+		records = records[:sort.Search(len(records), func(i int) bool {
+			return !records[i].FetchedAt.Before(cut)
+		})]
+	}
+
+	response := &pb.RateTimeSeriesResponse{
+		From:        from.Code,
+		To:          to.Code,
+		Granularity: granularity,
+	}
+
+	for _, candle := range candles(server.rateSamples(seed, records, from, to), period) {
+		response.Points = append(response.Points, &pb.RatePoint{
+			Time: timestamppb.New(candle.start),
+			Rate: candle.close.String(),
+		})
+
+		if request.GetIncludeCandles() {
+			response.Candles = append(response.Candles, &pb.RateCandle{
+				Time:    timestamppb.New(candle.start),
+				Open:    candle.open.String(),
+				High:    candle.high.String(),
+				Low:     candle.low.String(),
+				Close:   candle.close.String(),
+				Samples: candle.samples,
+			})
+		}
+	}
+
+	if pageEnd.Before(end) {
+		series.Next = pageEnd.Unix()
+		response.NextPageToken = series.encode()
+	}
+
+	return response, nil
+}
+
+// closingRecords returns the last rates table recorded against every base currency every day of the period, from
+// start to end, ordered by fetch time. The earlier refreshes of the days are left out.
+func (server *converterServer) closingRecords(
+	exProvider exchange.ProviderType,
+	start, end time.Time,
+	period time.Duration) ([]*cache.Rates, error) {
+	var records []*cache.Rates
+	for day := start; day.Before(end); day = day.Add(period) {
+		dayEnd := day.Add(period)
+		if end.Before(dayEnd) {
+			dayEnd = end
+		}
+
+		tables, err := server.history.Before(exProvider, dayEnd)
+		if errors.IsNotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		// the tables of an earlier day, the day has no refresh.
+		for _, table := range tables {
+			if !table.FetchedAt.Before(day) {
+				records = append(records, table)
+			}
+		}
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].FetchedAt.Before(records[j].FetchedAt)
+	})
+
+	return records, nil
+}
+
+// rateSample is the rate of a pair derived after a refresh of the rates.
+type rateSample struct {
+	at   time.Time
+	rate converter.Decimal
+}
+
+// rateSamples derives the rate converting from into to after every recorded rates table, ordered by fetch time.
+// Every rate is derived from the last table recorded against every base currency so far, starting with the seed
+// tables recorded before, through the pivot currencies if needed. The tables the rate cannot be derived from yet
+// are skipped.
+func (server *converterServer) rateSamples(seed, records []*cache.Rates, from, to currency.Currency) []rateSample {
+	pivots := server.pivots()
+
+	// the legs of the last table of every base, built once per table; the bases are kept ordered as the tables
+	// of the cache.
+	baseLegs := map[string]map[string][]leg{}
+	var bases []string
+
+	add := func(table *cache.Rates) {
+		if _, present := baseLegs[table.Base]; !present {
+			i := sort.Search(len(bases), func(i int) bool {
+				return !pivotRank(pivots, bases[i], table.Base)
+			})
+
+			bases = append(bases, "")
+			copy(bases[i+1:], bases[i:])
+			bases[i] = table.Base
+		}
+
+		baseLegs[table.Base] = legs([]*cache.Rates{table})
+	}
+
+	for _, table := range seed {
+		add(table)
+	}
+
+	var samples []rateSample
+	for _, record := range records {
+		add(record)
+
+		edges := map[string][]leg{}
+		for _, base := range bases {
+			for code, codeLegs := range baseLegs[base] {
+				edges[code] = append(edges[code], codeLegs...)
+			}
+		}
+
+		rate, err := server.exchangeRateThrough(edges, from, to)
+		if err != nil {
+			continue
+		}
+
+		samples = append(samples, rateSample{at: record.FetchedAt, rate: rate.rate})
+	}
+
+	return samples
+}
+
+// candle holds the open, high, low and close rates of a period.
+type candle struct {
+	start                  time.Time
+	open, high, low, close converter.Decimal
+	samples                uint32
+}
+
+// candles aggregates the samples, ordered by time, by period. The periods without samples are left out.
+func candles(samples []rateSample, period time.Duration) []*candle {
+	var aggregated []*candle
+	for _, sample := range samples {
+		start := sample.at.UTC().Truncate(period)
+
+		if n := len(aggregated); n == 0 || !aggregated[n-1].start.Equal(start) {
+			aggregated = append(aggregated, &candle{
+				start: start, open: sample.rate, high: sample.rate, low: sample.rate, close: sample.rate,
+			})
+		}
+
+		current := aggregated[len(aggregated)-1]
+		if sample.rate.Cmp(current.high) > 0 {
+			current.high = sample.rate
+		}
+
+		if sample.rate.Cmp(current.low) < 0 {
+			current.low = sample.rate
+		}
+
+		current.close = sample.rate
+		current.samples++
+	}
+
+	return aggregated
+}
+
+// timeSeriesToken is the position of a paged time series, the start of the next period to be returned.
+type timeSeriesToken struct {
+	Provider    exchange.ProviderType    `json:"p"`
+	From        string                   `json:"f"`
+	To          string                   `json:"t"`
+	Granularity pb.TimeSeriesGranularity `json:"g"`
+
+	// Start and End are the unix times of the range of the series.
+	Start int64 `json:"s"`
+	End   int64 `json:"e"`
+
+	// Next is the unix time of the start of the next period.
+	Next int64 `json:"n"`
+}
+
+// encode returns the opaque form of the token.
+func (token timeSeriesToken) encode() string {
+	content, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(content)
+}
+
+// decodeTimeSeriesToken returns the token from its opaque form, as long as it was issued for the same series.
+func decodeTimeSeriesToken(encoded string, series timeSeriesToken) (timeSeriesToken, error) {
+	content, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return timeSeriesToken{}, invalidPageTokenError
+	}
+
+	var token timeSeriesToken
+	if err = json.Unmarshal(content, &token); err != nil {
+		return timeSeriesToken{}, invalidPageTokenError
+	}
+
+	series.Next = token.Next
+	if token != series || token.Next <= token.Start || token.Next >= token.End {
+		return timeSeriesToken{}, invalidPageTokenError
+	}
+
+	return token, nil
+}
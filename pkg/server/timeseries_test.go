@@ -0,0 +1,208 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "currency-converter/api/pb/v1alpha1/currencyconverter"
+	"currency-converter/internal/cache"
+	"currency-converter/pkg/currency"
+)
+
+func TestRateSamples(t *testing.T) {
+	server := newTestServer(t).(*converterServer)
+
+	at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	record := func(minutes int, base string, values map[string]float32) *cache.Rates {
+		return &cache.Rates{Base: base, Values: values, FetchedAt: at.Add(time.Duration(minutes) * time.Minute)}
+	}
+
+	seed := []*cache.Rates{record(-60, "CHF", map[string]float32{"GBP": 3})}
+
+	records := []*cache.Rates{
+		// no rate against USD yet.
+		record(0, "EUR", map[string]float32{"GBP": 0.9}),
+		record(1, "USD", map[string]float32{"EUR": 0.8}),
+		record(2, "CHF", map[string]float32{"GBP": 2}),
+		record(3, "USD", map[string]float32{"EUR": 0.5}),
+		record(4, "EUR", map[string]float32{"GBP": 1}),
+	}
+
+	want := []struct {
+		minutes int
+		rate    string
+	}{
+		{minutes: 1, rate: "0.72"},
+		{minutes: 2, rate: "0.72"},
+		{minutes: 3, rate: "0.45"},
+		{minutes: 4, rate: "0.5"},
+	}
+
+	usd, _ := currency.Lookup("USD")
+	gbp, _ := currency.Lookup("GBP")
+
+	samples := server.rateSamples(seed, records, usd, gbp)
+	if len(samples) != len(want) {
+		t.Fatalf("got %d samples, want %d", len(samples), len(want))
+	}
+
+	for i, sample := range samples {
+		if !sample.at.Equal(at.Add(time.Duration(want[i].minutes)*time.Minute)) || sample.rate.String() != want[i].rate {
+			t.Errorf("got the sample %d [%s] at [%s], want [%s] after %d minutes",
+				i, sample.rate, sample.at, want[i].rate, want[i].minutes)
+		}
+	}
+}
+
+func TestRateTimeSeriesPagesDeriveTheRatesFromTheTablesRecordedBefore(t *testing.T) {
+	server := newTestServer(t).(*converterServer)
+	exProvider := server.settings.Get().Exchange.DefaultProvider
+
+	// the history of the last days is kept, an older one would be pruned once recorded.
+	day := time.Now().UTC().Truncate(24 * time.Hour).Add(-48 * time.Hour)
+
+	for _, rates := range []*cache.Rates{
+		{Base: "USD", Values: map[string]float32{"EUR": 0.8}, FetchedAt: day.Add(10 * time.Minute)},
+		{Base: "EUR", Values: map[string]float32{"GBP": 0.9}, FetchedAt: day.Add(20 * time.Minute)},
+		{Base: "EUR", Values: map[string]float32{"GBP": 1}, FetchedAt: day.Add(80 * time.Minute)},
+	} {
+		if err := server.history.Record(exProvider, rates); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	request := &pb.RateTimeSeriesRequest{
+		From:        "USD",
+		To:          "GBP",
+		StartTime:   timestamppb.New(day),
+		EndTime:     timestamppb.New(day.Add(2 * time.Hour)),
+		Granularity: pb.TimeSeriesGranularity_TIME_SERIES_GRANULARITY_HOUR,
+		PageSize:    1,
+	}
+
+	var rates []string
+	for {
+		response, err := server.GetRateTimeSeries(context.Background(), request)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, point := range response.GetPoints() {
+			rates = append(rates, point.GetRate())
+		}
+
+		if response.GetNextPageToken() == "" {
+			break
+		}
+
+		request.PageToken = response.GetNextPageToken()
+	}
+
+	// the second page has only a table against EUR, the rate against USD is the one recorded in the first page.
+	if len(rates) != 2 || rates[0] != "0.72" || rates[1] != "0.8" {
+		t.Errorf("got the rates %v, want [0.72 0.8]", rates)
+	}
+}
+
+func TestDailyRateTimeSeriesReadsTheClosingTablesOfTheDays(t *testing.T) {
+	server := newTestServer(t).(*converterServer)
+	exProvider := server.settings.Get().Exchange.DefaultProvider
+
+	// the history of the last days is kept, an older one would be pruned once recorded.
+	day := time.Now().UTC().Truncate(24 * time.Hour).Add(-96 * time.Hour)
+
+	for _, rates := range []*cache.Rates{
+		{Base: "USD", Values: map[string]float32{"EUR": 0.8}, FetchedAt: day.Add(time.Hour)},
+		{Base: "USD", Values: map[string]float32{"EUR": 0.5}, FetchedAt: day.Add(23 * time.Hour)},
+		{Base: "USD", Values: map[string]float32{"EUR": 0.6}, FetchedAt: day.Add(50 * time.Hour)},
+		{Base: "USD", Values: map[string]float32{"EUR": 0.7}, FetchedAt: day.Add(51 * time.Hour)},
+	} {
+		if err := server.history.Record(exProvider, rates); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	response, err := server.GetRateTimeSeries(context.Background(), &pb.RateTimeSeriesRequest{
+		From:           "USD",
+		To:             "EUR",
+		StartTime:      timestamppb.New(day),
+		EndTime:        timestamppb.New(day.Add(72 * time.Hour)),
+		IncludeCandles: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		day  int
+		rate string
+	}{
+		{day: 0, rate: "0.5"},
+		{day: 2, rate: "0.7"},
+	}
+
+	if len(response.GetCandles()) != len(want) {
+		t.Fatalf("got the candles %v, want %v", response.GetCandles(), want)
+	}
+
+	for i, candle := range response.GetCandles() {
+		wantTime := day.Add(time.Duration(want[i].day) * 24 * time.Hour)
+		if !candle.GetTime().AsTime().Equal(wantTime) || candle.GetClose() != want[i].rate || candle.GetSamples() != 1 {
+			t.Errorf("got the candle %v, want the close [%s] of [%s] from 1 sample", candle, want[i].rate, wantTime)
+		}
+	}
+}
+
+func TestRateTimeSeriesPageEndsBeforeTheRecordsLimit(t *testing.T) {
+	server := newTestServer(t).(*converterServer)
+	exProvider := server.settings.Get().Exchange.DefaultProvider
+
+	// the history of the last days is kept, an older one would be pruned once recorded.
+	hour := time.Now().UTC().Truncate(24 * time.Hour).Add(-48 * time.Hour)
+
+	// the first hour has fewer tables than the limit, both hours together more.
+	perHour := maxPageRecords * 3 / 5
+	for i := 0; i < 2*perHour; i++ {
+		at := hour.Add(time.Duration(i) * time.Hour / time.Duration(perHour))
+		rates := &cache.Rates{Base: "USD", Values: map[string]float32{"EUR": 0.8}, FetchedAt: at}
+		if err := server.history.Record(exProvider, rates); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	response, err := server.GetRateTimeSeries(context.Background(), &pb.RateTimeSeriesRequest{
+		From:        "USD",
+		To:          "EUR",
+		StartTime:   timestamppb.New(hour),
+		EndTime:     timestamppb.New(hour.Add(2 * time.Hour)),
+		Granularity: pb.TimeSeriesGranularity_TIME_SERIES_GRANULARITY_HOUR,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(response.GetPoints()) != 1 || response.GetNextPageToken() == "" {
+		t.Fatalf("got %d points and the next page token [%s], want the first hour and a next page",
+			len(response.GetPoints()), response.GetNextPageToken())
+	}
+
+	response, err = server.GetRateTimeSeries(context.Background(), &pb.RateTimeSeriesRequest{
+		From:        "USD",
+		To:          "EUR",
+		StartTime:   timestamppb.New(hour),
+		EndTime:     timestamppb.New(hour.Add(2 * time.Hour)),
+		Granularity: pb.TimeSeriesGranularity_TIME_SERIES_GRANULARITY_HOUR,
+		PageToken:   response.GetNextPageToken(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(response.GetPoints()) != 1 || response.GetNextPageToken() != "" {
+		t.Errorf("got %d points and the next page token [%s], want the second hour and no next page",
+			len(response.GetPoints()), response.GetNextPageToken())
+	}
+}
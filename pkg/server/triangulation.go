@@ -0,0 +1,161 @@
+package server
+
+import (
+	"sort"
+	"time"
+
+	"currency-converter/internal/cache"
+	"currency-converter/pkg/converter"
+)
+
+// leg is a step of a conversion path, from a currency to another, read from a rates table.
+type leg struct {
+	from, to string
+
+	// the rate of the leg is numerator / denominator, so that a whole path is divided only once.
+	numerator, denominator converter.Decimal
+
+	rates *cache.Rates
+}
+
+// path is a chain of legs converting a currency into another.
+type path []leg
+
+// currencies returns all the currencies of the path in order, e.g. ["EUR", "USD", "BTC"].
+func (p path) currencies(from string) []string {
+	codes := []string{from}
+	for _, l := range p {
+		codes = append(codes, l.to)
+	}
+
+	return codes
+}
+
+// fetchedAt returns the time at which the oldest rates table of the path was fetched.
+func (p path) fetchedAt() time.Time {
+	var oldest time.Time
+	for _, l := range p {
+		if oldest.IsZero() || l.rates.FetchedAt.Before(oldest) {
+			oldest = l.rates.FetchedAt
+		}
+	}
+
+	return oldest
+}
+
+// rate returns the exchange rate of the whole path, rounded half to even to converter.MaxScale digits.
+func (p path) rate() (converter.Decimal, error) {
+	numerator, denominator := converter.NewDecimal(1, 0), converter.NewDecimal(1, 0)
+
+	var err error
+	for _, l := range p {
+		if numerator, err = numerator.Mul(l.numerator); err != nil {
+			return converter.Decimal{}, err
+		}
+
+		if denominator, err = denominator.Mul(l.denominator); err != nil {
+			return converter.Decimal{}, err
+		}
+	}
+
+	return converter.CrossRate(denominator, numerator)
+}
+
+// singleTable returns the rates table of the path when the whole path is read from it.
+func (p path) singleTable() (*cache.Rates, bool) {
+	if len(p) == 0 {
+		return nil, false
+	}
+
+	for _, l := range p {
+		if l.rates != p[0].rates {
+			return nil, false
+		}
+	}
+
+	return p[0].rates, true
+}
+
+// legs returns the legs of every rates table, in both directions, by source currency.
+func legs(tables []*cache.Rates) map[string][]leg {
+	one := converter.NewDecimal(1, 0)
+
+	edges := map[string][]leg{}
+	for _, table := range tables {
+		for code, value := range table.Values {
+			rate := converter.NewFromFloat32(value)
+			if code == table.Base || rate.Sign() <= 0 {
+				continue
+			}
+
+			edges[table.Base] = append(edges[table.Base], leg{from: table.Base, to: code, numerator: rate, denominator: one, rates: table})
+			edges[code] = append(edges[code], leg{from: code, to: table.Base, numerator: one, denominator: rate, rates: table})
+		}
+	}
+
+	return edges
+}
+
+// findPath searches the legs of the rates tables for the shortest path converting from into to, crossing only the
+// pivots. Among the shortest paths, the one whose oldest rates table is the freshest wins.
+// pivots holds the rank of every pivot currency, the preferred pivots are tried first.
+func findPath(edges map[string][]leg, from, to string, pivots map[string]int) (path, bool) {
+	if from == to {
+		return path{}, true
+	}
+
+	visited := map[string]path{from: {}}
+	frontier := []string{from}
+
+	for len(frontier) > 0 {
+		next := map[string]path{}
+
+		for _, node := range frontier {
+			if _, pivot := pivots[node]; node != from && !pivot {
+				continue
+			}
+
+			for _, l := range edges[node] {
+				if _, seen := visited[l.to]; seen {
+					continue
+				}
+
+				candidate := append(append(path{}, visited[node]...), l)
+				if current, found := next[l.to]; !found || candidate.fetchedAt().After(current.fetchedAt()) {
+					next[l.to] = candidate
+				}
+			}
+		}
+
+		if p, found := next[to]; found {
+			return p, true
+		}
+
+		frontier = frontier[:0]
+		for node, p := range next {
+			visited[node] = p
+			frontier = append(frontier, node)
+		}
+
+		sort.Slice(frontier, func(i, j int) bool {
+			return pivotRank(pivots, frontier[i], frontier[j])
+		})
+	}
+
+	return nil, false
+}
+
+// pivotRank returns true if the currency a is crossed before b: the pivots by preference, then the others by code.
+func pivotRank(pivots map[string]int, a, b string) bool {
+	rankA, pivotA := pivots[a]
+	rankB, pivotB := pivots[b]
+
+	switch {
+	case pivotA && pivotB:
+		return rankA < rankB
+	case pivotA != pivotB:
+		return pivotA
+	default:
+		return a < b
+	}
+}
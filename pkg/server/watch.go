@@ -0,0 +1,223 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "currency-converter/api/pb/v1alpha1/currencyconverter"
+	"currency-converter/internal/cache"
+	"currency-converter/internal/errors"
+	"currency-converter/internal/exchange"
+	"currency-converter/pkg/currency"
+	"currency-converter/pkg/locale"
+)
+
+func (server *converterServer) WatchExchangeRates(
+	request *pb.WatchExchangeRatesRequest,
+	stream pb.CurrencyConverterService_WatchExchangeRatesServer) error {
+	// TODO: User authentication using ctx
+
+	base, codes, err := lookupQuoted(request.GetBase(), request.GetCodes())
+	if err != nil {
+		return err
+	}
+
+	providers := []exchange.ProviderType{server.settings.Get().Exchange.DefaultProvider}
+	if len(request.GetExchangeProviders()) > 0 {
+		providers = providers[:0]
+		for _, requested := range request.GetExchangeProviders() {
+			if !exchange.IsSupportedProvider(exchange.ProviderType(requested)) {
+				return errors.FieldViolationError("exchange_providers", fmt.Sprintf("unsupported provider [%s]", requested))
+			}
+
+			providers = append(providers, exchange.ProviderType(requested))
+		}
+	}
+
+	w := &watcher{server: server, stream: stream, base: base, codes: codes, sent: map[exchange.ProviderType]string{}}
+
+	// subscribed first, so that no change is missed between the snapshot and the updates.
+	subscription := server.watch.Subscribe()
+	defer subscription.Close()
+
+	versions := server.watch.Versions()
+	for _, version := range versions {
+		w.delivered = maxVersion(w.delivered, version)
+	}
+
+	resume, current := server.watch.Version(request.GetResumeFromVersion())
+	if !current || resume == 0 || resume > w.delivered {
+		// the version is unknown, e.g. it was issued before a restart of the service.
+		if err = w.snapshot(providers); err != nil {
+			return err
+		}
+	} else {
+		for _, exProvider := range providers {
+			if versions[exProvider] <= resume {
+				continue
+			}
+
+			if err = w.update(exProvider); err != nil {
+				return err
+			}
+		}
+	}
+
+	interval := server.settings.Get().Server.WatchHeartbeat
+	heartbeat := time.NewTicker(interval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-subscription.Changes():
+			for exProvider, version := range subscription.Take() {
+				if containsProvider(providers, exProvider) {
+					if err = w.update(exProvider); err != nil {
+						return err
+					}
+				}
+
+				w.delivered = maxVersion(w.delivered, version)
+			}
+
+			heartbeat.Reset(interval)
+
+		case <-heartbeat.C:
+			if err = stream.Send(&pb.WatchExchangeRatesResponse{
+				Event: &pb.WatchExchangeRatesResponse_Heartbeat{Heartbeat: &pb.Heartbeat{
+					Time:    timestamppb.Now(),
+					Version: server.watch.Token(w.delivered),
+				}},
+			}); err != nil {
+				return err
+			}
+
+		case <-stream.Context().Done():
+			return nil
+
+		case <-server.shutdown:
+			// the client resumes from the last version delivered, with another server.
+			return errors.ShuttingDownError
+		}
+	}
+}
+
+// watcher sends the watched rates of a stream.
+type watcher struct {
+	server *converterServer
+	stream pb.CurrencyConverterService_WatchExchangeRatesServer
+
+	base  currency.Currency
+	codes []currency.Currency
+
+	// sent holds the last rates sent, by exchange provider, so that unchanged rates are not sent again.
+	sent map[exchange.ProviderType]string
+
+	// delivered is the version of the last rates sent, all the changes up to it were sent.
+	// The clients are given its token, see watch.Hub.Token.
+	delivered uint64
+}
+
+// snapshot sends the rates of all the providers. The providers without rates are left out of it,
+// they are sent as updates once they get rates.
+func (w *watcher) snapshot(providers []exchange.ProviderType) error {
+	snapshot := &pb.RatesSnapshot{}
+
+	for _, exProvider := range providers {
+		tables, err := w.server.rateTables(exProvider)
+		if err != nil {
+			logrus.WithError(err).Warnf("no rates of the provider [%s] for the snapshot of a watch", exProvider)
+			continue
+		}
+
+		rates, version := w.providerRates(exProvider, tables)
+		w.sent[exProvider] = fingerprint(rates.GetCurrencies())
+
+		snapshot.Rates = append(snapshot.Rates, rates)
+		w.delivered = maxVersion(w.delivered, version)
+	}
+
+	snapshot.Version = w.server.watch.Token(w.delivered)
+
+	return w.stream.Send(&pb.WatchExchangeRatesResponse{
+		Event: &pb.WatchExchangeRatesResponse_Snapshot{Snapshot: snapshot},
+	})
+}
+
+// update sends the current rates of the provider, unless they did not change since they were last sent.
+func (w *watcher) update(exProvider exchange.ProviderType) error {
+	tables, err := w.server.cachedRateTables(exProvider)
+	if err != nil || len(tables) == 0 {
+		return nil
+	}
+
+	rates, version := w.providerRates(exProvider, tables)
+
+	changed := fingerprint(rates.GetCurrencies())
+	if w.sent[exProvider] == changed {
+		return nil
+	}
+
+	w.sent[exProvider] = changed
+	w.delivered = maxVersion(w.delivered, version)
+
+	return w.stream.Send(&pb.WatchExchangeRatesResponse{
+		Event: &pb.WatchExchangeRatesResponse_Update{Update: rates},
+	})
+}
+
+// providerRates returns the watched rates of the provider from its rates tables, and their version.
+func (w *watcher) providerRates(exProvider exchange.ProviderType, tables []*cache.Rates) (*pb.ProviderRates, uint64) {
+	rates := w.server.ratesAgainst(tables, w.base, w.codes)
+
+	providerRates := &pb.ProviderRates{
+		ExchangeProvider:     string(exProvider),
+		Base:                 w.base.Code,
+		Currencies:           listed(rates, "", locale.Canonical),
+		ExchangeRateDatetime: timestamppb.New(oldestFetch(rates)),
+	}
+
+	var version uint64
+	for _, table := range tables {
+		version = maxVersion(version, table.Version)
+	}
+
+	providerRates.Version = w.server.watch.Token(version)
+
+	return providerRates, version
+}
+
+// fingerprint returns the codes and values of the currencies, to compare them.
+func fingerprint(currencies []*pb.Currency) string {
+	var b strings.Builder
+	for _, c := range currencies {
+		b.WriteString(c.GetCode())
+		b.WriteString("=")
+		b.WriteString(c.GetValue())
+		b.WriteString(";")
+	}
+
+	return b.String()
+}
+
+func containsProvider(providers []exchange.ProviderType, exProvider exchange.ProviderType) bool {
+	for _, p := range providers {
+		if p == exProvider {
+			return true
+		}
+	}
+
+	return false
+}
+
+func maxVersion(a, b uint64) uint64 {
+	if a > b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	pb "currency-converter/api/pb/v1alpha1/currencyconverter"
+	"currency-converter/internal/cache"
+	"currency-converter/internal/cache/inmemory"
+	"currency-converter/internal/config"
+	"currency-converter/internal/health"
+	"currency-converter/internal/history/file"
+	"currency-converter/internal/watch"
+)
+
+func TestWatchResumeAcrossRestartSendsSnapshot(t *testing.T) {
+	cfg := config.Default()
+	cfg.History.Dir = t.TempDir()
+
+	settings := config.NewHolder(cfg)
+	monitor := health.NewMonitor(settings)
+	hub := watch.NewHub()
+	store := inmemory.NewStore(settings, monitor, hub)
+
+	// the restarted service issued more versions than the one resumed from, before the restart.
+	for i := 0; i < 5; i++ {
+		rates := &cache.Rates{Base: "USD", Values: map[string]float32{"EUR": 0.9}, FetchedAt: time.Now()}
+		if err := store.SetRates(cfg.Exchange.DefaultProvider, rates, time.Hour); err != nil {
+			t.Fatal(err)
+		}
+
+		// recorded as a refresh of the provider would.
+		if err := hub.Record(cfg.Exchange.DefaultProvider, rates); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	beforeRestart := watch.NewHub()
+
+	tests := []struct {
+		name     string
+		resume   uint64
+		snapshot bool
+	}{
+		{name: "token of the previous boot", resume: beforeRestart.Token(3), snapshot: true},
+		{name: "token of the current boot", resume: hub.Token(5), snapshot: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			shutdown := make(chan struct{})
+			server := NewServer(store, inmemory.NewQuoteStore(), file.NewStore(settings), hub, monitor, settings, shutdown)
+
+			stream := &watchStream{}
+			_ = endsOnShutdown(t, shutdown, func() error {
+				return server.WatchExchangeRates(&pb.WatchExchangeRatesRequest{ResumeFromVersion: test.resume}, stream)
+			})
+
+			snapshot := len(stream.events) > 0 && stream.events[0].GetSnapshot() != nil
+			if snapshot != test.snapshot {
+				t.Fatalf("got a snapshot: %t, want: %t", snapshot, test.snapshot)
+			}
+		})
+	}
+}